@@ -0,0 +1,100 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Aggregate returns changes of all releases newer than a "from" version, up to and including a "to" version,
+// combined into a single set of changes (for example: upgrade notes from 1.2.0 to 1.7.3).
+//
+// Entries of every scope and notices are listed starting from the newest release,
+// duplicate entries and releases that were yanked are omitted.
+func (c *Changelog) Aggregate(from, to string) (*Changes, error) {
+	return c.aggregate(from, to, false)
+}
+
+// AggregateAnnotated returns changes of a range of releases combined into a single set of changes.
+//
+// Identical to Aggregate but every entry and notice is annotated with a version of its release (for example: "Bug (1.3.0)").
+func (c *Changelog) AggregateAnnotated(from, to string) (*Changes, error) {
+	return c.aggregate(from, to, true)
+}
+
+func (c *Changelog) aggregate(from, to string, annotate bool) (*Changes, error) {
+	for _, v := range []string{from, to} {
+		if c.GetRelease(v) == nil {
+			return nil, &ReleaseNotFoundError{Version: v}
+		}
+	}
+
+	if compareVersions(from, to) >= 0 {
+		return nil, errors.New(fmt.Sprintf("invalid range: %v is not lower than %v", from, to))
+	}
+
+	releases, err := c.Releases.Between(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return combineChanges(releases.NotYanked(), annotate), nil
+}
+
+// combineChanges returns notices and entries of all releases in a provided order, duplicate entries are omitted.
+func combineChanges(releases Releases, annotate bool) *Changes {
+	o := new(Changes)
+	var notices []string
+	seen := make(map[scopedEntry]bool)
+
+	for _, r := range releases {
+		if r.Changes == nil {
+			continue
+		}
+
+		if r.Changes.Notice != nil && *r.Changes.Notice != "" {
+			notices = append(notices, annotateEntry(*r.Changes.Notice, *r.Version, annotate))
+		}
+
+		for _, s := range r.Changes.scopes() {
+			if s.entries == nil {
+				continue
+			}
+
+			f, _ := o.scopeField(s.name)
+			for _, e := range *s.entries {
+				if seen[scopedEntry{s.name, e}] {
+					continue
+				}
+				seen[scopedEntry{s.name, e}] = true
+
+				e = annotateEntry(e, *r.Version, annotate)
+				if *f == nil {
+					*f = &[]string{e}
+				} else {
+					**f = append(**f, e)
+				}
+			}
+		}
+	}
+
+	if len(notices) > 0 {
+		n := strings.Join(notices, "\n\n")
+		o.Notice = &n
+	}
+
+	return o
+}
+
+// annotateEntry appends a version to the first line of an entry.
+func annotateEntry(entry, version string, annotate bool) string {
+	if !annotate {
+		return entry
+	}
+
+	lines := strings.SplitN(entry, "\n", 2)
+	lines[0] = fmt.Sprintf("%v (%v)", lines[0], version)
+
+	return strings.Join(lines, "\n")
+}
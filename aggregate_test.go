@@ -0,0 +1,134 @@
+package changelog_test
+
+import (
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/stretchr/testify/assert"
+)
+
+const aggregateChangelog = `# Changelog
+
+## [1.3.0] - 2021-05-22
+
+### Fixed
+
+- D
+
+## [1.2.1] - 2021-05-21 [YANKED]
+
+> Yanked: broken build
+
+### Fixed
+
+- C
+
+## [1.2.0] - 2021-05-20
+
+Configuration format was changed.
+
+### Changed
+
+- B
+  with details
+
+### Fixed
+
+- D
+
+## [1.1.0] - 2021-05-19
+
+### Added
+
+- A
+
+## [1.0.0] - 2021-05-18
+
+Initial release.
+
+### Added
+
+- Z
+`
+
+func TestAggregate(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		From      string
+		To        string
+		Annotated bool
+		Expected  *changelog.Changes
+		Error     string
+	}
+
+	suite := map[string]test{
+		"Range": {
+			From: "1.0.0",
+			To:   "1.3.0",
+			Expected: &changelog.Changes{
+				Added:   &[]string{"A"},
+				Changed: &[]string{"B\nwith details"},
+				Fixed:   &[]string{"D"},
+				Notice:  stringP("Configuration format was changed."),
+			},
+		},
+		"Annotated": {
+			From:      "1.0.0",
+			To:        "1.3.0",
+			Annotated: true,
+			Expected: &changelog.Changes{
+				Added:   &[]string{"A (1.1.0)"},
+				Changed: &[]string{"B (1.2.0)\nwith details"},
+				Fixed:   &[]string{"D (1.3.0)"},
+				Notice:  stringP("Configuration format was changed. (1.2.0)"),
+			},
+		},
+		"Single Release": {
+			From: "1.2.1",
+			To:   "1.3.0",
+			Expected: &changelog.Changes{
+				Fixed: &[]string{"D"},
+			},
+		},
+		"Yanked Release": {
+			From:     "1.2.0",
+			To:       "1.2.1",
+			Expected: &changelog.Changes{},
+		},
+		"Missing Release": {
+			From:  "1.0.0",
+			To:    "1.4.0",
+			Error: "release 1.4.0 not found",
+		},
+		"Invalid Range": {
+			From:  "1.3.0",
+			To:    "1.0.0",
+			Error: "invalid range: 1.3.0 is not lower than 1.0.0",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c := parseChangelog(t, aggregateChangelog)
+
+		var changes *changelog.Changes
+		var err error
+		if test.Annotated {
+			changes, err = c.AggregateAnnotated(test.From, test.To)
+		} else {
+			changes, err = c.Aggregate(test.From, test.To)
+		}
+
+		if test.Error != "" {
+			a.EqualError(err, test.Error)
+		} else {
+			a.Equal(nil, err)
+		}
+		a.Equal(test.Expected, changes)
+		a.Equal(aggregateChangelog, c.ToString())
+	}
+}
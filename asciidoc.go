@@ -0,0 +1,156 @@
+package changelog
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// AsciiDocRenderer renders a changelog as AsciiDoc.
+//
+// Every release heading has an anchor (for example: [[release-1.0.0]]),
+// release URLs are defined as document attributes (for example: :url-1-0-0:)
+// and a reason of a yanked release is rendered as a warning.
+type AsciiDocRenderer struct {
+	// ScopeOrder defaults to DefaultScopeOrder.
+	ScopeOrder ScopeOrder
+	// PreserveScopeOrder renders scopes of parsed changes in order of their appearance in a file.
+	PreserveScopeOrder bool
+}
+
+// RenderChangelog writes an AsciiDoc formatted Changelog struct.
+func (a *AsciiDocRenderer) RenderChangelog(w io.Writer, c *Changelog) error {
+	var o []string
+	var header []string
+	var sections []string
+
+	if c.Title != nil {
+		header = append(header, fmt.Sprintf("= %v", *c.Title))
+	}
+
+	releases := make(Releases, 0)
+	if c.Unreleased != nil {
+		releases = append(releases, c.Unreleased)
+	}
+	releases = append(releases, c.Releases.descending()...)
+
+	for _, r := range releases {
+		s, d := a.release(r)
+		sections = append(sections, s)
+		if d != "" {
+			header = append(header, d)
+		}
+	}
+
+	if len(header) > 0 {
+		o = append(o, fmt.Sprintf("%v\n", strings.Join(header, "\n")))
+	}
+
+	if c.Description != nil {
+		o = append(o, fmt.Sprintf("%v\n", *c.Description))
+	}
+
+	o = append(o, sections...)
+
+	_, err := io.WriteString(w, strings.Join(o, "\n"))
+	return err
+}
+
+// RenderRelease writes an AsciiDoc formatted Release struct preceded by its URL attribute.
+func (a *AsciiDocRenderer) RenderRelease(w io.Writer, r *Release) error {
+	o, d := a.release(r)
+	if d != "" {
+		o = strings.Join([]string{d, "", o}, "\n")
+	}
+
+	_, err := io.WriteString(w, o)
+	return err
+}
+
+// RenderChanges writes an AsciiDoc formatted Changes struct.
+func (a *AsciiDocRenderer) RenderChanges(w io.Writer, c *Changes) error {
+	_, err := io.WriteString(w, a.changes(c))
+	return err
+}
+
+func (a *AsciiDocRenderer) release(r *Release) (string, string) {
+	var o []string
+	var d string
+
+	name := "Unreleased"
+	if r.Version != nil {
+		name = *r.Version
+	}
+
+	title := name
+	if r.URL != nil {
+		attr := asciiDocAttribute(name)
+		title = fmt.Sprintf("{%v}[%v]", attr, name)
+		d = fmt.Sprintf(":%v: %v", attr, *r.URL)
+	}
+
+	if r.Version != nil && r.Date != nil {
+		title = fmt.Sprintf("%v - %v", title, formatDate(r.Date))
+	}
+
+	if r.Yanked {
+		title = fmt.Sprintf("%v [YANKED]", title)
+	}
+
+	o = append(o, fmt.Sprintf("[[%v]]\n== %v\n", releaseAnchor(name), title))
+
+	if r.Yanked && r.YankReason != nil {
+		o = append(o, fmt.Sprintf("WARNING: Yanked: %v\n", *r.YankReason))
+	}
+
+	if r.Changes != nil {
+		o = append(o, a.changes(r.Changes))
+	}
+
+	return strings.Join(o, "\n"), d
+}
+
+func (a *AsciiDocRenderer) changes(c *Changes) string {
+	var o []string
+	if c.Notice != nil {
+		o = append(o, fmt.Sprintf("%v\n", *c.Notice))
+	}
+
+	for _, s := range c.orderedScopes(a.ScopeOrder, a.PreserveScopeOrder) {
+		if s.entries != nil {
+			var entries []string
+			for _, e := range *s.entries {
+				entries = append(entries, asciiDocEntry(e))
+			}
+
+			o = append(o, fmt.Sprintf("=== %v\n", s.name), fmt.Sprintf("%v\n", strings.Join(entries, "\n")))
+		}
+	}
+
+	return strings.Join(o, "\n")
+}
+
+// asciiDocEntry formats a list item, attaching empty lines to it with a list continuation.
+func asciiDocEntry(entry string) string {
+	lines := strings.Split(entry, "\n")
+	m := regexp.MustCompile(EmptyLineRegex)
+
+	for i, l := range lines {
+		if m.MatchString(l) {
+			lines[i] = "+"
+		}
+	}
+
+	return fmt.Sprintf("* %v", strings.Join(lines, "\n"))
+}
+
+// asciiDocAttribute returns a valid attribute name for a release URL.
+func asciiDocAttribute(name string) string {
+	return fmt.Sprintf("url-%v", regexp.MustCompile(`[^a-z0-9]+`).ReplaceAllString(strings.ToLower(name), "-"))
+}
+
+// releaseAnchor returns an anchor of a release heading.
+func releaseAnchor(name string) string {
+	return fmt.Sprintf("release-%v", strings.ToLower(name))
+}
@@ -0,0 +1,148 @@
+package changelog_test
+
+import (
+	"bytes"
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func documentationChangelog() *changelog.Changelog {
+	return &changelog.Changelog{
+		Title:       stringP("Changelog"),
+		Description: stringP("description"),
+		Unreleased: &changelog.Release{
+			URL: stringP("https://github.com/anton-yurchenko/go-changelog/compare/v1.0.0...HEAD"),
+			Changes: &changelog.Changes{
+				Added: sliceOfStringsP([]string{"A"}),
+			},
+		},
+		Releases: changelog.Releases{
+			{
+				Version: stringP("1.0.0"),
+				Date:    dateP("2021-05-19"),
+				URL:     stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0"),
+				Changes: &changelog.Changes{
+					Notice:   stringP("notice"),
+					Fixed:    sliceOfStringsP([]string{"B\n\ncode"}),
+					Security: sliceOfStringsP([]string{"C"}),
+				},
+			},
+			{
+				Version: stringP("1.1.0-rc.1"),
+			},
+		},
+	}
+}
+
+func TestAsciiDocRenderer(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Changelog *changelog.Changelog
+		Expected  string
+	}
+
+	suite := map[string]test{
+		"Empty": {
+			Changelog: new(changelog.Changelog),
+			Expected:  "",
+		},
+		"Title": {
+			Changelog: &changelog.Changelog{
+				Title: stringP("Changelog"),
+			},
+			Expected: "= Changelog\n",
+		},
+		"Full": {
+			Changelog: documentationChangelog(),
+			Expected: `= Changelog
+:url-unreleased: https://github.com/anton-yurchenko/go-changelog/compare/v1.0.0...HEAD
+:url-1-0-0: https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0
+
+description
+
+[[release-unreleased]]
+== {url-unreleased}[Unreleased]
+
+=== Added
+
+* A
+
+[[release-1.1.0-rc.1]]
+== 1.1.0-rc.1
+
+[[release-1.0.0]]
+== {url-1-0-0}[1.0.0] - 2021-05-19
+
+notice
+
+=== Security
+
+* C
+
+=== Fixed
+
+* B
++
+code
+`,
+		},
+		"Yanked": {
+			Changelog: &changelog.Changelog{
+				Releases: changelog.Releases{
+					{
+						Version:    stringP("1.0.0"),
+						Date:       dateP("2021-05-19"),
+						Yanked:     true,
+						YankReason: stringP("broken build"),
+						Changes:    &changelog.Changes{Fixed: sliceOfStringsP([]string{"A"})},
+					},
+					{
+						Version: stringP("0.9.0"),
+						Date:    dateP("2021-05-01"),
+						Yanked:  true,
+					},
+				},
+			},
+			Expected: "[[release-1.0.0]]\n== 1.0.0 - 2021-05-19 [YANKED]\n\nWARNING: Yanked: broken build\n\n=== Fixed\n\n* A\n\n[[release-0.9.0]]\n== 0.9.0 - 2021-05-01 [YANKED]\n",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		b := new(bytes.Buffer)
+		a.Equal(nil, new(changelog.AsciiDocRenderer).RenderChangelog(b, test.Changelog))
+		a.Equal(test.Expected, b.String())
+	}
+}
+
+func TestAsciiDocRendererRelease(t *testing.T) {
+	a := assert.New(t)
+	r := new(changelog.AsciiDocRenderer)
+
+	t.Log("Test Case 1/2 - Release")
+	b := new(bytes.Buffer)
+	a.Equal(nil, r.RenderRelease(b, &changelog.Release{
+		Version: stringP("1.0.0"),
+		Date:    dateP("2021-05-19"),
+		URL:     stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0"),
+	}))
+	a.Equal(`:url-1-0-0: https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0
+
+[[release-1.0.0]]
+== {url-1-0-0}[1.0.0] - 2021-05-19
+`, b.String())
+
+	t.Log("Test Case 2/2 - Changes")
+	b.Reset()
+	a.Equal(nil, r.RenderChanges(b, &changelog.Changes{
+		Deprecated: sliceOfStringsP([]string{"A", "B"}),
+	}))
+	a.Equal("=== Deprecated\n\n* A\n* B\n", b.String())
+}
@@ -2,10 +2,11 @@ package changelog
 
 import (
 	"fmt"
+	"io"
 	"io/fs"
 	"net/url"
-	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/afero"
@@ -26,38 +27,25 @@ type Changelog struct {
 	Description *string
 	Unreleased  *Release
 	Releases    Releases
+	// Markdown configures output of ToString, SaveToFile and WriteTo, defaults are used when nil.
+	Markdown *MarkdownRenderer
+	// Clock provides dates of releases created "today", defaults to SystemClock.
+	Clock Clock
+	// Location is a time zone of dates of releases created "today", defaults to UTC.
+	Location *time.Location
 }
 
 // ToString returns a Markdown formatted Changelog struct.
 func (c *Changelog) ToString() string {
-	var o []string
-	var defs []string
-
-	if c.Title != nil {
-		o = append(o, fmt.Sprintf("# %v\n", *c.Title))
-	}
-
-	if c.Description != nil {
-		o = append(o, fmt.Sprintf("%v\n", *c.Description))
-	}
-
-	if c.Unreleased != nil {
-		u, d := c.Unreleased.ToString()
-		o = append(o, u)
-		defs = append(defs, d)
-	}
-
-	sort.Sort(sort.Reverse(c.Releases))
+	return c.markdown().changelog(c)
+}
 
-	for _, release := range c.Releases {
-		r, d := release.ToString()
-		o = append(o, r)
-		defs = append(defs, d)
+func (c *Changelog) markdown() *MarkdownRenderer {
+	if c.Markdown != nil {
+		return c.Markdown
 	}
 
-	o = append(o, defs...)
-
-	return strings.Join(o, "\n")
+	return new(MarkdownRenderer)
 }
 
 // SaveToFile formats the changelog struct according to a predefined format
@@ -65,14 +53,21 @@ func (c *Changelog) ToString() string {
 //
 // Possible options for Filesystem are: [afero.NewOsFs(), afero.NewMemMapFs()].
 func (c *Changelog) SaveToFile(filesystem Filesystem, filepath string) error {
+	return c.SaveToFileWithRenderer(filesystem, filepath, c.markdown())
+}
+
+// SaveToFileWithRenderer formats the changelog struct using a provided renderer
+// and prints it to file.
+//
+// Identical to SaveToFile but with a custom output format.
+func (c *Changelog) SaveToFileWithRenderer(filesystem Filesystem, filepath string, renderer Renderer) error {
 	f, err := filesystem.Create(filepath)
 	if err != nil {
 		return errors.Wrap(err, "error creating a file")
 	}
 	defer f.Close()
 
-	_, err = f.WriteString(c.ToString())
-	if err != nil {
+	if err := renderer.RenderChangelog(f, c); err != nil {
 		return errors.Wrap(err, "error writing to file")
 	}
 
@@ -83,6 +78,22 @@ func (c *Changelog) SaveToFile(filesystem Filesystem, filepath string) error {
 	return nil
 }
 
+// WriteTo writes a Markdown formatted changelog to w.
+//
+// Implements io.WriterTo interface.
+func (c *Changelog) WriteTo(w io.Writer) (int64, error) {
+	return c.WriteToWithRenderer(w, c.markdown())
+}
+
+// WriteToWithRenderer writes a changelog formatted by a provided renderer to w.
+//
+// Identical to WriteTo but with a custom output format.
+func (c *Changelog) WriteToWithRenderer(w io.Writer, renderer Renderer) (int64, error) {
+	cw := &countingWriter{w: w}
+	err := renderer.RenderChangelog(cw, c)
+	return cw.n, err
+}
+
 // NewChangelog returns an empty changelog.
 func NewChangelog() *Changelog {
 	c := new(Changelog)
@@ -136,6 +147,50 @@ func (c *Changelog) AddUnreleasedChange(scope string, change string) error {
 	return c.Unreleased.Changes.AddChange(scope, change)
 }
 
+// RemoveUnreleasedChange removes the first matching change from a scope of Unreleased section.
+//
+// This is a helper function that wraps Changes.RemoveChange function.
+func (c *Changelog) RemoveUnreleasedChange(scope string, change string) error {
+	return c.unreleasedChanges().RemoveChange(scope, change)
+}
+
+// ReplaceUnreleasedChange replaces the first matching change in a scope of Unreleased section.
+//
+// This is a helper function that wraps Changes.ReplaceChange function.
+func (c *Changelog) ReplaceUnreleasedChange(scope string, change string, replacement string) error {
+	return c.unreleasedChanges().ReplaceChange(scope, change, replacement)
+}
+
+// RemoveUnreleasedChangeAt removes a change at a position (starting from 0) of a scope of Unreleased section.
+//
+// This is a helper function that wraps Changes.RemoveChangeAt function.
+func (c *Changelog) RemoveUnreleasedChangeAt(scope string, index int) error {
+	return c.unreleasedChanges().RemoveChangeAt(scope, index)
+}
+
+// ReplaceUnreleasedChangeAt replaces a change at a position (starting from 0) of a scope of Unreleased section.
+//
+// This is a helper function that wraps Changes.ReplaceChangeAt function.
+func (c *Changelog) ReplaceUnreleasedChangeAt(scope string, index int, replacement string) error {
+	return c.unreleasedChanges().ReplaceChangeAt(scope, index, replacement)
+}
+
+// ClearUnreleasedScope removes all changes of a scope of Unreleased section.
+//
+// This is a helper function that wraps Changes.ClearScope function.
+func (c *Changelog) ClearUnreleasedScope(scope string) error {
+	return c.unreleasedChanges().ClearScope(scope)
+}
+
+// unreleasedChanges returns changes of Unreleased section, or empty changes when it is missing.
+func (c *Changelog) unreleasedChanges() *Changes {
+	if c.Unreleased == nil || c.Unreleased.Changes == nil {
+		return new(Changes)
+	}
+
+	return c.Unreleased.Changes
+}
+
 // GetRelease returns a release for a provided version.
 //
 // This is a helper function that wraps Releases.GetRelease function.
@@ -143,6 +198,123 @@ func (c *Changelog) GetRelease(version string) *Release {
 	return c.Releases.GetRelease(version)
 }
 
+// DeleteRelease removes a release of a provided version.
+func (c *Changelog) DeleteRelease(version string) error {
+	for i, r := range c.Releases {
+		if *r.Version == version {
+			c.Releases = append(c.Releases[:i], c.Releases[i+1:]...)
+			return nil
+		}
+	}
+
+	return &ReleaseNotFoundError{Version: version}
+}
+
+// RenameRelease changes a version of a release.
+//
+// Occurrences of the old version in URLs of all releases and Unreleased section
+// (for example: compare/v1.0.0...v1.1.0) are replaced by the new version.
+func (c *Changelog) RenameRelease(old, new string) error {
+	r := c.GetRelease(old)
+	if r == nil {
+		return &ReleaseNotFoundError{Version: old}
+	}
+
+	if _, err := parseSemVer(new); err != nil {
+		return err
+	}
+
+	if old == new {
+		return nil
+	}
+
+	if c.GetRelease(new) != nil {
+		return errors.New(fmt.Sprintf("version %v already exists", new))
+	}
+
+	renamed := *r
+	renamed.Version = &new
+	if err := c.Releases.checkChronology(&renamed); err != nil {
+		return err
+	}
+
+	r.Version = &new
+
+	releases := append(Releases{c.Unreleased}, c.Releases...)
+	for _, x := range releases {
+		if x != nil && x.URL != nil {
+			u := replaceVersion(*x.URL, old, new)
+			x.URL = &u
+		}
+	}
+
+	return nil
+}
+
+// SetReleaseDate changes a date of a release.
+// Expected format: YYYY-MM-DD
+//
+// The date must be consistent with dates of other releases of the same major and minor version:
+// not older than dates of lower versions and not newer than dates of higher versions.
+func (c *Changelog) SetReleaseDate(version, date string) error {
+	r := c.GetRelease(version)
+	if r == nil {
+		return &ReleaseNotFoundError{Version: version}
+	}
+
+	x := *r
+	if err := x.SetDate(date); err != nil {
+		return err
+	}
+
+	if err := c.Releases.checkChronology(&x); err != nil {
+		return err
+	}
+
+	r.Date = x.Date
+	return nil
+}
+
+// SetReleaseDateToday changes a date of a release to a current date.
+//
+// Identical to SetReleaseDate but with a date returned by Today.
+func (c *Changelog) SetReleaseDateToday(version string) error {
+	return c.SetReleaseDate(version, c.Today())
+}
+
+// Yank marks a release as yanked, an empty reason is omitted.
+//
+// A reason is rendered on a single line, so its line breaks and repeated spaces are replaced by single spaces.
+func (c *Changelog) Yank(version, reason string) error {
+	r := c.GetRelease(version)
+	if r == nil {
+		return &ReleaseNotFoundError{Version: version}
+	}
+
+	reason = strings.Join(strings.Fields(reason), " ")
+
+	r.Yanked = true
+	r.YankReason = nil
+	if reason != "" {
+		r.YankReason = &reason
+	}
+
+	return nil
+}
+
+// Unyank removes a yanked mark and its reason from a release.
+func (c *Changelog) Unyank(version string) error {
+	r := c.GetRelease(version)
+	if r == nil {
+		return &ReleaseNotFoundError{Version: version}
+	}
+
+	r.Yanked = false
+	r.YankReason = nil
+
+	return nil
+}
+
 // CreateReleaseFromUnreleased creates a new release with all the changes from Unreleased section.
 // This will also cleanup the Unreleased section.
 func (c *Changelog) CreateReleaseFromUnreleased(version, date string) (*Release, error) {
@@ -165,10 +165,10 @@ notice
 ### Added
 
 - A:
-` + "```yaml" + `
-this:
-  that:
-    - x` + "```" + `
+  ` + "```yaml" + `
+  this:
+    that:
+      - x` + "```" + `
 
 - B
 
@@ -485,6 +485,473 @@ func TestChangelogGetRelease(t *testing.T) {
 	}
 }
 
+func TestDeleteRelease(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Version  string
+		Expected changelog.Releases
+		Error    string
+	}
+
+	suite := map[string]test{
+		"Found": {
+			Version: "1.0.0",
+			Expected: changelog.Releases{
+				{Version: stringP("1.1.0")},
+				{Version: stringP("0.1.0")},
+			},
+		},
+		"Not Found": {
+			Version: "2.0.0",
+			Expected: changelog.Releases{
+				{Version: stringP("1.1.0")},
+				{Version: stringP("1.0.0")},
+				{Version: stringP("0.1.0")},
+			},
+			Error: "release 2.0.0 not found",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c := &changelog.Changelog{
+			Releases: changelog.Releases{
+				{Version: stringP("1.1.0")},
+				{Version: stringP("1.0.0")},
+				{Version: stringP("0.1.0")},
+			},
+		}
+
+		err := c.DeleteRelease(test.Version)
+		if test.Error != "" {
+			a.EqualError(err, test.Error)
+
+			var notFound *changelog.ReleaseNotFoundError
+			a.True(errors.As(err, &notFound))
+			a.Equal(test.Version, notFound.Version)
+		} else {
+			a.Equal(nil, err)
+		}
+		a.Equal(test.Expected, c.Releases)
+	}
+}
+
+func TestRenameRelease(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Old        string
+		New        string
+		Unreleased string
+		Versions   []string
+		URLs       []string
+		Error      string
+	}
+
+	suite := map[string]test{
+		"Latest": {
+			Old:        "1.1.0",
+			New:        "1.2.0",
+			Unreleased: "https://github.com/o/r/compare/v1.2.0...HEAD",
+			Versions:   []string{"1.2.0", "1.0.0", "1.0.0-rc.1"},
+			URLs: []string{
+				"https://github.com/o/r/compare/v1.0.0...v1.2.0",
+				"https://github.com/o/r/compare/v1.0.0-rc.1...v1.0.0",
+				"https://github.com/o/r/releases/tag/v1.0.0-rc.1",
+			},
+		},
+		"Middle": {
+			Old:        "1.0.0",
+			New:        "1.0.1",
+			Unreleased: "https://github.com/o/r/compare/v1.1.0...HEAD",
+			Versions:   []string{"1.1.0", "1.0.1", "1.0.0-rc.1"},
+			URLs: []string{
+				"https://github.com/o/r/compare/v1.0.1...v1.1.0",
+				"https://github.com/o/r/compare/v1.0.0-rc.1...v1.0.1",
+				"https://github.com/o/r/releases/tag/v1.0.0-rc.1",
+			},
+		},
+		"Same Version": {
+			Old:        "1.0.0",
+			New:        "1.0.0",
+			Unreleased: "https://github.com/o/r/compare/v1.1.0...HEAD",
+			Versions:   []string{"1.1.0", "1.0.0", "1.0.0-rc.1"},
+			URLs: []string{
+				"https://github.com/o/r/compare/v1.0.0...v1.1.0",
+				"https://github.com/o/r/compare/v1.0.0-rc.1...v1.0.0",
+				"https://github.com/o/r/releases/tag/v1.0.0-rc.1",
+			},
+		},
+		"Not Found": {
+			Old:        "2.0.0",
+			New:        "2.0.1",
+			Unreleased: "https://github.com/o/r/compare/v1.1.0...HEAD",
+			Versions:   []string{"1.1.0", "1.0.0", "1.0.0-rc.1"},
+			URLs: []string{
+				"https://github.com/o/r/compare/v1.0.0...v1.1.0",
+				"https://github.com/o/r/compare/v1.0.0-rc.1...v1.0.0",
+				"https://github.com/o/r/releases/tag/v1.0.0-rc.1",
+			},
+			Error: "release 2.0.0 not found",
+		},
+		"Invalid Version": {
+			Old:        "1.1.0",
+			New:        "v1.2",
+			Unreleased: "https://github.com/o/r/compare/v1.1.0...HEAD",
+			Versions:   []string{"1.1.0", "1.0.0", "1.0.0-rc.1"},
+			URLs: []string{
+				"https://github.com/o/r/compare/v1.0.0...v1.1.0",
+				"https://github.com/o/r/compare/v1.0.0-rc.1...v1.0.0",
+				"https://github.com/o/r/releases/tag/v1.0.0-rc.1",
+			},
+			Error: "invalid semantic version v1.2",
+		},
+		"Collision": {
+			Old:        "1.1.0",
+			New:        "1.0.0",
+			Unreleased: "https://github.com/o/r/compare/v1.1.0...HEAD",
+			Versions:   []string{"1.1.0", "1.0.0", "1.0.0-rc.1"},
+			URLs: []string{
+				"https://github.com/o/r/compare/v1.0.0...v1.1.0",
+				"https://github.com/o/r/compare/v1.0.0-rc.1...v1.0.0",
+				"https://github.com/o/r/releases/tag/v1.0.0-rc.1",
+			},
+			Error: "version 1.0.0 already exists",
+		},
+		"Inconsistent Date": {
+			Old:        "1.0.0",
+			New:        "1.0.0-rc.0",
+			Unreleased: "https://github.com/o/r/compare/v1.1.0...HEAD",
+			Versions:   []string{"1.1.0", "1.0.0", "1.0.0-rc.1"},
+			URLs: []string{
+				"https://github.com/o/r/compare/v1.0.0...v1.1.0",
+				"https://github.com/o/r/compare/v1.0.0-rc.1...v1.0.0",
+				"https://github.com/o/r/releases/tag/v1.0.0-rc.1",
+			},
+			Error: "date 2021-02-01 of release 1.0.0-rc.0 is inconsistent with date 2021-01-01 of release 1.0.0-rc.1",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c := &changelog.Changelog{
+			Unreleased: &changelog.Release{URL: stringP("https://github.com/o/r/compare/v1.1.0...HEAD")},
+			Releases: changelog.Releases{
+				{
+					Version: stringP("1.1.0"),
+					Date:    parseDate("2021-03-01"),
+					URL:     stringP("https://github.com/o/r/compare/v1.0.0...v1.1.0"),
+				},
+				{
+					Version: stringP("1.0.0"),
+					Date:    parseDate("2021-02-01"),
+					URL:     stringP("https://github.com/o/r/compare/v1.0.0-rc.1...v1.0.0"),
+				},
+				{
+					Version: stringP("1.0.0-rc.1"),
+					Date:    parseDate("2021-01-01"),
+					URL:     stringP("https://github.com/o/r/releases/tag/v1.0.0-rc.1"),
+				},
+			},
+		}
+
+		err := c.RenameRelease(test.Old, test.New)
+		if test.Error != "" {
+			a.Error(err)
+			if err != nil {
+				a.Contains(err.Error(), test.Error)
+			}
+		} else {
+			a.Equal(nil, err)
+		}
+
+		a.Equal(test.Unreleased, *c.Unreleased.URL)
+		for i, r := range c.Releases {
+			a.Equal(test.Versions[i], *r.Version)
+			a.Equal(test.URLs[i], *r.URL)
+		}
+	}
+}
+
+func TestSetReleaseDate(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Version  string
+		Date     string
+		Expected *time.Time
+		Error    string
+	}
+
+	suite := map[string]test{
+		"Consistent": {
+			Version:  "1.1.1",
+			Date:     "2021-02-15",
+			Expected: parseDate("2021-02-15"),
+		},
+		"Same Date As Neighbour": {
+			Version:  "1.1.1",
+			Date:     "2021-03-01",
+			Expected: parseDate("2021-03-01"),
+		},
+		"Other Major Version": {
+			Version:  "2.0.0",
+			Date:     "2021-02-15",
+			Expected: parseDate("2021-02-15"),
+		},
+		"Backport": {
+			Version:  "1.0.1",
+			Date:     "2021-05-01",
+			Expected: parseDate("2021-05-01"),
+		},
+		"Older Than Lower Version": {
+			Version:  "1.1.1",
+			Date:     "2020-12-31",
+			Expected: parseDate("2021-02-01"),
+			Error:    "date 2020-12-31 of release 1.1.1 is inconsistent with date 2021-01-01 of release 1.1.0",
+		},
+		"Newer Than Higher Version": {
+			Version:  "1.1.1",
+			Date:     "2021-03-02",
+			Expected: parseDate("2021-02-01"),
+			Error:    "date 2021-03-02 of release 1.1.1 is inconsistent with date 2021-03-01 of release 1.1.2",
+		},
+		"Invalid Date": {
+			Version:  "1.1.1",
+			Date:     "2021-13-01",
+			Expected: parseDate("2021-02-01"),
+			Error:    "invalid date 2021-13-01",
+		},
+		"Not Found": {
+			Version: "3.0.0",
+			Date:    "2021-02-15",
+			Error:   "release 3.0.0 not found",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c := &changelog.Changelog{
+			Releases: changelog.Releases{
+				{Version: stringP("2.0.0"), Date: parseDate("2021-04-01")},
+				{Version: stringP("1.1.2"), Date: parseDate("2021-03-01")},
+				{Version: stringP("1.1.1"), Date: parseDate("2021-02-01")},
+				{Version: stringP("1.1.0"), Date: parseDate("2021-01-01")},
+				{Version: stringP("1.0.1"), Date: parseDate("2021-04-15")},
+				{Version: stringP("1.0.0"), Date: parseDate("2020-12-01")},
+			},
+		}
+
+		err := c.SetReleaseDate(test.Version, test.Date)
+		if test.Error != "" {
+			a.Error(err)
+			if err != nil {
+				a.Contains(err.Error(), test.Error)
+			}
+		} else {
+			a.Equal(nil, err)
+		}
+
+		if r := c.GetRelease(test.Version); r != nil {
+			a.Equal(test.Expected, r.Date)
+		}
+	}
+
+	t.Log("Test Case 8/8 - Today")
+	c := &changelog.Changelog{
+		Clock:    fixedClock(time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)),
+		Releases: changelog.Releases{{Version: stringP("1.0.0"), Date: parseDate("2021-01-01")}},
+	}
+	a.Equal(nil, c.SetReleaseDateToday("1.0.0"))
+	a.Equal(parseDate("2021-05-01"), c.Releases[0].Date)
+}
+
+func TestUnreleasedChangesEditing(t *testing.T) {
+	a := assert.New(t)
+
+	t.Log("Test Case 1/4 - Missing Unreleased")
+	c := changelog.NewChangelog()
+
+	err := c.RemoveUnreleasedChange("added", "A")
+	a.EqualError(err, `change "A" not found in scope added`)
+
+	var notFound *changelog.ChangeNotFoundError
+	a.True(errors.As(err, &notFound))
+	a.Equal("A", notFound.Change)
+
+	a.EqualError(c.ReplaceUnreleasedChange("added", "A", "B"), `change "A" not found in scope added`)
+	a.EqualError(c.RemoveUnreleasedChangeAt("added", 0), "change 0 not found in scope added")
+	a.EqualError(c.ReplaceUnreleasedChangeAt("added", 0, "B"), "change 0 not found in scope added")
+
+	err = c.ClearUnreleasedScope("added")
+	a.EqualError(err, "scope added is empty")
+
+	var empty *changelog.EmptyScopeError
+	a.True(errors.As(err, &empty))
+	a.Equal("added", empty.Scope)
+
+	var unsupported *changelog.UnsupportedScopeError
+	a.True(errors.As(c.ClearUnreleasedScope("invalid"), &unsupported))
+	a.Equal("invalid", unsupported.Scope)
+	a.Nil(c.Unreleased)
+
+	t.Log("Test Case 2/4 - Replace")
+	for _, e := range []string{"A", "B", "C"} {
+		if err := c.AddUnreleasedChange("added", e); err != nil {
+			t.Fatalf("error preparing test case: %v", err)
+		}
+	}
+	if err := c.AddUnreleasedChange("fixed", "D"); err != nil {
+		t.Fatalf("error preparing test case: %v", err)
+	}
+
+	a.Equal(nil, c.ReplaceUnreleasedChange("added", "B", "X"))
+	a.Equal(sliceOfStringsP([]string{"A", "X", "C"}), c.Unreleased.Changes.Added)
+	a.Equal(nil, c.ReplaceUnreleasedChangeAt("added", 2, "Y"))
+	a.Equal(sliceOfStringsP([]string{"A", "X", "Y"}), c.Unreleased.Changes.Added)
+
+	t.Log("Test Case 3/4 - Remove")
+	a.Equal(nil, c.RemoveUnreleasedChange("added", "A"))
+	a.Equal(sliceOfStringsP([]string{"X", "Y"}), c.Unreleased.Changes.Added)
+	a.Equal(nil, c.RemoveUnreleasedChangeAt("added", 1))
+	a.Equal(sliceOfStringsP([]string{"X"}), c.Unreleased.Changes.Added)
+
+	t.Log("Test Case 4/4 - Clear")
+	a.Equal(nil, c.ClearUnreleasedScope("added"))
+	a.Equal(&changelog.Changes{Fixed: sliceOfStringsP([]string{"D"})}, c.Unreleased.Changes)
+}
+
+func TestYank(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Version  string
+		Reason   string
+		Expected *changelog.Release
+		Error    string
+	}
+
+	suite := map[string]test{
+		"With Reason": {
+			Version: "1.0.0",
+			Reason:  "broken build",
+			Expected: &changelog.Release{
+				Version:    stringP("1.0.0"),
+				Yanked:     true,
+				YankReason: stringP("broken build"),
+			},
+		},
+		"Multi-line Reason": {
+			Version: "1.0.0",
+			Reason:  "broken\n  build\n",
+			Expected: &changelog.Release{
+				Version:    stringP("1.0.0"),
+				Yanked:     true,
+				YankReason: stringP("broken build"),
+			},
+		},
+		"Without Reason": {
+			Version: "1.0.0",
+			Expected: &changelog.Release{
+				Version: stringP("1.0.0"),
+				Yanked:  true,
+			},
+		},
+		"Blank Reason": {
+			Version: "1.0.0",
+			Reason:  " \n",
+			Expected: &changelog.Release{
+				Version: stringP("1.0.0"),
+				Yanked:  true,
+			},
+		},
+		"Not Found": {
+			Version: "2.0.0",
+			Error:   "release 2.0.0 not found",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c := &changelog.Changelog{
+			Releases: changelog.Releases{
+				{
+					Version:    stringP("1.0.0"),
+					YankReason: stringP("previous reason"),
+				},
+			},
+		}
+
+		err := c.Yank(test.Version, test.Reason)
+		if test.Error != "" {
+			a.EqualError(err, test.Error)
+		} else {
+			a.Equal(nil, err)
+			a.Equal(test.Expected, c.GetRelease(test.Version))
+		}
+	}
+}
+
+func TestUnyank(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Version  string
+		Expected *changelog.Release
+		Error    string
+	}
+
+	suite := map[string]test{
+		"Found": {
+			Version: "1.0.0",
+			Expected: &changelog.Release{
+				Version: stringP("1.0.0"),
+			},
+		},
+		"Not Found": {
+			Version: "2.0.0",
+			Error:   "release 2.0.0 not found",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c := &changelog.Changelog{
+			Releases: changelog.Releases{
+				{
+					Version:    stringP("1.0.0"),
+					Yanked:     true,
+					YankReason: stringP("broken build"),
+				},
+			},
+		}
+
+		err := c.Unyank(test.Version)
+		if test.Error != "" {
+			a.EqualError(err, test.Error)
+		} else {
+			a.Equal(nil, err)
+			a.Equal(test.Expected, c.GetRelease(test.Version))
+		}
+	}
+}
+
 func TestChangelogCreateRelease(t *testing.T) {
 	a := assert.New(t)
 
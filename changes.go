@@ -16,49 +16,128 @@ type Changes struct {
 	Notice     *string
 	Removed    *[]string
 	Security   *[]string
+	// Order contains scopes in order of their appearance in a parsed file.
+	//
+	// Changes can not be compared with == since Order is a slice, use reflect.DeepEqual instead.
+	Order ScopeOrder
 }
 
 // ToString returns a Markdown formatted Changes struct.
 func (c *Changes) ToString() string {
-	var o []string
-	if c.Notice != nil {
-		o = append(o, fmt.Sprintf("%v\n", *c.Notice))
-	}
+	return new(MarkdownRenderer).changes(c)
+}
 
-	if c.Security != nil {
-		o = append(o, "### Security\n", fmt.Sprintf("%v\n", scopeToString(c.Security)))
-	}
+// ScopeOrder is an order of scopes rendering.
+//
+// Scopes that are missing from the order follow in DefaultScopeOrder.
+type ScopeOrder []string
+
+var (
+	// DefaultScopeOrder sorts scopes by their importance (from a point of view of a developer).
+	DefaultScopeOrder = ScopeOrder{"Security", "Changed", "Added", "Removed", "Fixed", "Deprecated"}
+	// KeepAChangelogScopeOrder sorts scopes as listed by Keep a Changelog specification.
+	KeepAChangelogScopeOrder = ScopeOrder{"Added", "Changed", "Deprecated", "Removed", "Fixed", "Security"}
+	// CommonChangelogScopeOrder sorts scopes as listed by Common Changelog specification,
+	// followed by scopes that are not part of it.
+	CommonChangelogScopeOrder = ScopeOrder{"Changed", "Added", "Removed", "Fixed", "Deprecated", "Security"}
+)
+
+// NewScopeOrder returns a validated order of scopes.
+//
+// Supported scopes: [added, changed, deprecated, removed, fixed, security].
+func NewScopeOrder(scopes ...string) (ScopeOrder, error) {
+	o := make(ScopeOrder, 0, len(scopes))
+	seen := make(map[string]bool)
+
+	for _, s := range scopes {
+		name := scopeName(s)
+		if name == "" {
+			return nil, errors.New(fmt.Sprintf("unexpected scope: %v (supported: [added,changed,deprecated,removed,fixed,security])", s))
+		}
+
+		if seen[name] {
+			return nil, errors.New(fmt.Sprintf("duplicate scope: %v", s))
+		}
 
-	if c.Changed != nil {
-		o = append(o, "### Changed\n", fmt.Sprintf("%v\n", scopeToString(c.Changed)))
+		seen[name] = true
+		o = append(o, name)
 	}
 
-	if c.Added != nil {
-		o = append(o, "### Added\n", fmt.Sprintf("%v\n", scopeToString(c.Added)))
+	return o, nil
+}
+
+// scopeName returns a capitalized name of a scope, or an empty string for an unsupported scope.
+func scopeName(scope string) string {
+	for _, s := range DefaultScopeOrder {
+		if strings.EqualFold(s, scope) {
+			return s
+		}
 	}
 
-	if c.Removed != nil {
-		o = append(o, "### Removed\n", fmt.Sprintf("%v\n", scopeToString(c.Removed)))
+	return ""
+}
+
+// scope is a named list of entries.
+type scope struct {
+	name    string
+	entries *[]string
+}
+
+// scopes returns all scopes sorted by their importance.
+func (c *Changes) scopes() []scope {
+	return c.scopesInOrder(DefaultScopeOrder)
+}
+
+// orderedScopes returns all scopes sorted by a provided order,
+// or in order of their appearance in a file (followed by a provided order) when preserved.
+func (c *Changes) orderedScopes(order ScopeOrder, preserve bool) []scope {
+	if preserve && c.Order != nil {
+		order = append(append(ScopeOrder{}, c.Order...), order...)
 	}
 
-	if c.Fixed != nil {
-		o = append(o, "### Fixed\n", fmt.Sprintf("%v\n", scopeToString(c.Fixed)))
+	return c.scopesInOrder(order)
+}
+
+// scopesInOrder returns all scopes sorted by a provided order.
+func (c *Changes) scopesInOrder(order ScopeOrder) []scope {
+	entries := map[string]*[]string{
+		"Added":      c.Added,
+		"Changed":    c.Changed,
+		"Deprecated": c.Deprecated,
+		"Removed":    c.Removed,
+		"Fixed":      c.Fixed,
+		"Security":   c.Security,
 	}
 
-	if c.Deprecated != nil {
-		o = append(o, "### Deprecated\n", fmt.Sprintf("%v\n", scopeToString(c.Deprecated)))
+	o := make([]scope, 0, len(entries))
+	seen := make(map[string]bool)
+
+	for _, s := range append(append(ScopeOrder{}, order...), DefaultScopeOrder...) {
+		name := scopeName(s)
+		if name == "" || seen[name] {
+			continue
+		}
+
+		seen[name] = true
+		o = append(o, scope{name, entries[name]})
 	}
 
-	return strings.Join(o, "\n")
+	return o
 }
 
-func scopeToString(scope *[]string) string {
-	var o []string
-	for _, c := range *scope {
-		o = append(o, fmt.Sprintf("- %v", c))
+// empty reports whether changes contain neither a notice nor scopes.
+func (c *Changes) empty() bool {
+	if c.Notice != nil {
+		return false
+	}
+
+	for _, s := range c.scopes() {
+		if s.entries != nil {
+			return false
+		}
 	}
 
-	return strings.Join(o, "\n")
+	return true
 }
 
 // AddNotice adds a notice to the changes.
@@ -66,76 +145,165 @@ func (c *Changes) AddNotice(notice string) {
 	*c.Notice = notice
 }
 
-// AddChange adds a scoped change.
+// AddChange adds a scoped change normalized with NormalizeEntry, empty changes are ignored.
 //
 // Supported scopes: [added, changed, deprecated, removed, fixed, security].
 func (c *Changes) AddChange(scope string, change string) error {
-	changesList := []string{change}
+	f, err := c.scopeField(scope)
+	if err != nil {
+		return err
+	}
+
+	change = NormalizeEntry(change)
+	if change == "" {
+		return nil
+	}
 
+	if *f == nil {
+		*f = &[]string{change}
+	} else {
+		**f = append(**f, change)
+	}
+
+	return nil
+}
+
+// scopeField returns a field of a scope.
+//
+// Supported scopes: [added, changed, deprecated, removed, fixed, security].
+func (c *Changes) scopeField(scope string) (**[]string, error) {
 	switch strings.ToLower(scope) {
 	case "added":
-		if change == "" {
-			return nil
-		}
-
-		if c.Added == nil {
-			c.Added = &changesList
-		} else {
-			*c.Added = append(*c.Added, change)
-		}
+		return &c.Added, nil
 	case "changed":
-		if change == "" {
-			return nil
-		}
-
-		if c.Changed == nil {
-			c.Changed = &changesList
-		} else {
-			*c.Changed = append(*c.Changed, change)
-		}
+		return &c.Changed, nil
 	case "deprecated":
-		if change == "" {
-			return nil
-		}
-
-		if c.Deprecated == nil {
-			c.Deprecated = &changesList
-		} else {
-			*c.Deprecated = append(*c.Deprecated, change)
-		}
+		return &c.Deprecated, nil
 	case "removed":
-		if change == "" {
-			return nil
-		}
-
-		if c.Removed == nil {
-			c.Removed = &changesList
-		} else {
-			*c.Removed = append(*c.Removed, change)
-		}
+		return &c.Removed, nil
 	case "fixed":
-		if change == "" {
-			return nil
-		}
-
-		if c.Fixed == nil {
-			c.Fixed = &changesList
-		} else {
-			*c.Fixed = append(*c.Fixed, change)
-		}
+		return &c.Fixed, nil
 	case "security":
-		if change == "" {
-			return nil
-		}
+		return &c.Security, nil
+	default:
+		return nil, &UnsupportedScopeError{Scope: scope}
+	}
+}
+
+// changeIndex returns a position of the first change matching a normalized text.
+func (c *Changes) changeIndex(scope string, change string) (**[]string, int, error) {
+	f, err := c.scopeField(scope)
+	if err != nil {
+		return nil, 0, err
+	}
 
-		if c.Security == nil {
-			c.Security = &changesList
-		} else {
-			*c.Security = append(*c.Security, change)
+	if *f != nil {
+		change = NormalizeEntry(change)
+		for i, e := range **f {
+			if e == change {
+				return f, i, nil
+			}
 		}
-	default:
-		return errors.New(fmt.Sprintf("unexpected scope: %v (supported: [added,changed,deprecated,removed,fixed,security])", scope))
 	}
 
+	return nil, 0, &ChangeNotFoundError{Scope: strings.ToLower(scope), Change: change}
+}
+
+// RemoveChange removes the first matching change from a scope.
+//
+// Supported scopes: [added, changed, deprecated, removed, fixed, security].
+func (c *Changes) RemoveChange(scope string, change string) error {
+	f, i, err := c.changeIndex(scope, change)
+	if err != nil {
+		return err
+	}
+
+	removeChange(f, i)
+	return nil
+}
+
+// RemoveChangeAt removes a change at a position (starting from 0) of a scope.
+//
+// Supported scopes: [added, changed, deprecated, removed, fixed, security].
+func (c *Changes) RemoveChangeAt(scope string, index int) error {
+	f, err := c.scopeField(scope)
+	if err != nil {
+		return err
+	}
+
+	if *f == nil || index < 0 || index >= len(**f) {
+		return &ChangeNotFoundError{Scope: strings.ToLower(scope), Index: &index}
+	}
+
+	removeChange(f, index)
+	return nil
+}
+
+// removeChange removes a change from a scope, a scope without changes is cleared.
+func removeChange(f **[]string, index int) {
+	o := append((**f)[:index:index], (**f)[index+1:]...)
+	if len(o) == 0 {
+		*f = nil
+		return
+	}
+
+	*f = &o
+}
+
+// ReplaceChange replaces the first matching change in a scope with a replacement normalized with NormalizeEntry.
+//
+// Supported scopes: [added, changed, deprecated, removed, fixed, security].
+func (c *Changes) ReplaceChange(scope string, change string, replacement string) error {
+	replacement = NormalizeEntry(replacement)
+	if replacement == "" {
+		return errors.New("replacement must not be empty")
+	}
+
+	f, i, err := c.changeIndex(scope, change)
+	if err != nil {
+		return err
+	}
+
+	(**f)[i] = replacement
+	return nil
+}
+
+// ReplaceChangeAt replaces a change at a position (starting from 0) of a scope
+// with a replacement normalized with NormalizeEntry.
+//
+// Supported scopes: [added, changed, deprecated, removed, fixed, security].
+func (c *Changes) ReplaceChangeAt(scope string, index int, replacement string) error {
+	replacement = NormalizeEntry(replacement)
+	if replacement == "" {
+		return errors.New("replacement must not be empty")
+	}
+
+	f, err := c.scopeField(scope)
+	if err != nil {
+		return err
+	}
+
+	if *f == nil || index < 0 || index >= len(**f) {
+		return &ChangeNotFoundError{Scope: strings.ToLower(scope), Index: &index}
+	}
+
+	(**f)[index] = replacement
+	return nil
+}
+
+// ClearScope removes all changes of a scope, clearing an empty scope returns an EmptyScopeError.
+//
+// Supported scopes: [added, changed, deprecated, removed, fixed, security].
+func (c *Changes) ClearScope(scope string) error {
+	f, err := c.scopeField(scope)
+	if err != nil {
+		return err
+	}
+
+	if *f == nil {
+		return &EmptyScopeError{Scope: strings.ToLower(scope)}
+	}
+
+	*f = nil
 	return nil
 }
@@ -164,10 +164,10 @@ func TestChangesToString(t *testing.T) {
 ### Added
 
 - A:
-` + "```yaml" + `
-this:
-  that:
-    - x` + "```" + `
+  ` + "```yaml" + `
+  this:
+    that:
+      - x` + "```" + `
 
 - B
 `,
@@ -369,6 +369,26 @@ func TestAddChange(t *testing.T) {
 				Error: "",
 			},
 		},
+		"Normalized": {
+			Changes: new(changelog.Changes),
+			Scope:   "added",
+			Change:  "\n  first\r\nsecond \r\n",
+			Expected: expected{
+				Changes: &changelog.Changes{
+					Added: sliceOfStringsP([]string{"first\nsecond"}),
+				},
+				Error: "",
+			},
+		},
+		"Whitespace": {
+			Changes: new(changelog.Changes),
+			Scope:   "fixed",
+			Change:  " \r\n\t",
+			Expected: expected{
+				Changes: new(changelog.Changes),
+				Error:   "",
+			},
+		},
 		"Invalid Scope": {
 			Changes: &changelog.Changes{
 				Security: sliceOfStringsP([]string{"change"}),
@@ -474,3 +494,307 @@ func TestAddChange(t *testing.T) {
 		}
 	}
 }
+
+func TestNewScopeOrder(t *testing.T) {
+	a := assert.New(t)
+
+	type expected struct {
+		Order changelog.ScopeOrder
+		Error string
+	}
+
+	type test struct {
+		Scopes   []string
+		Expected expected
+	}
+
+	suite := map[string]test{
+		"Empty": {
+			Scopes: []string{},
+			Expected: expected{
+				Order: changelog.ScopeOrder{},
+			},
+		},
+		"Normalized": {
+			Scopes: []string{"fixed", "ADDED", "Security"},
+			Expected: expected{
+				Order: changelog.ScopeOrder{"Fixed", "Added", "Security"},
+			},
+		},
+		"Unexpected Scope": {
+			Scopes: []string{"added", "other"},
+			Expected: expected{
+				Error: "unexpected scope: other (supported: [added,changed,deprecated,removed,fixed,security])",
+			},
+		},
+		"Duplicate Scope": {
+			Scopes: []string{"added", "Added"},
+			Expected: expected{
+				Error: "duplicate scope: Added",
+			},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		o, err := changelog.NewScopeOrder(test.Scopes...)
+		a.Equal(test.Expected.Order, o)
+		if test.Expected.Error != "" || err != nil {
+			a.EqualError(err, test.Expected.Error)
+		}
+	}
+}
+
+func TestRemoveChange(t *testing.T) {
+	a := assert.New(t)
+
+	type expected struct {
+		Changes *changelog.Changes
+		Error   string
+	}
+
+	type test struct {
+		Scope    string
+		Change   string
+		Index    *int
+		Expected expected
+	}
+
+	index := func(i int) *int { return &i }
+
+	suite := map[string]test{
+		"By Text": {
+			Scope:  "Added",
+			Change: " B\n",
+			Expected: expected{
+				Changes: &changelog.Changes{
+					Added: sliceOfStringsP([]string{"A", "B"}),
+					Fixed: sliceOfStringsP([]string{"C"}),
+				},
+			},
+		},
+		"By Index": {
+			Scope: "added",
+			Index: index(2),
+			Expected: expected{
+				Changes: &changelog.Changes{
+					Added: sliceOfStringsP([]string{"A", "B"}),
+					Fixed: sliceOfStringsP([]string{"C"}),
+				},
+			},
+		},
+		"Last Change": {
+			Scope:  "fixed",
+			Change: "C",
+			Expected: expected{
+				Changes: &changelog.Changes{
+					Added: sliceOfStringsP([]string{"A", "B", "B"}),
+				},
+			},
+		},
+		"Missing Text": {
+			Scope:  "added",
+			Change: "X",
+			Expected: expected{
+				Error: `change "X" not found in scope added`,
+			},
+		},
+		"Empty Text": {
+			Scope:  "added",
+			Change: "",
+			Expected: expected{
+				Error: `change "" not found in scope added`,
+			},
+		},
+		"Missing Index": {
+			Scope: "Fixed",
+			Index: index(1),
+			Expected: expected{
+				Error: "change 1 not found in scope fixed",
+			},
+		},
+		"Negative Index": {
+			Scope: "fixed",
+			Index: index(-1),
+			Expected: expected{
+				Error: "change -1 not found in scope fixed",
+			},
+		},
+		"Empty Scope": {
+			Scope:  "security",
+			Change: "A",
+			Expected: expected{
+				Error: `change "A" not found in scope security`,
+			},
+		},
+		"Invalid Scope": {
+			Scope:  "invalid",
+			Change: "A",
+			Expected: expected{
+				Error: "unexpected scope: invalid (supported: [added,changed,deprecated,removed,fixed,security])",
+			},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c := &changelog.Changes{
+			Added: sliceOfStringsP([]string{"A", "B", "B"}),
+			Fixed: sliceOfStringsP([]string{"C"}),
+		}
+
+		var err error
+		if test.Index != nil {
+			err = c.RemoveChangeAt(test.Scope, *test.Index)
+		} else {
+			err = c.RemoveChange(test.Scope, test.Change)
+		}
+
+		if test.Expected.Error != "" {
+			a.EqualError(err, test.Expected.Error)
+		} else {
+			a.Equal(nil, err)
+			a.Equal(test.Expected.Changes, c)
+		}
+	}
+}
+
+func TestReplaceChange(t *testing.T) {
+	a := assert.New(t)
+
+	type expected struct {
+		Changes *changelog.Changes
+		Error   string
+	}
+
+	type test struct {
+		Scope       string
+		Change      string
+		Index       *int
+		Replacement string
+		Expected    expected
+	}
+
+	index := func(i int) *int { return &i }
+
+	suite := map[string]test{
+		"By Text": {
+			Scope:       "added",
+			Change:      "B",
+			Replacement: "X\r\n",
+			Expected: expected{
+				Changes: &changelog.Changes{
+					Added: sliceOfStringsP([]string{"A", "X", "B"}),
+				},
+			},
+		},
+		"By Index": {
+			Scope:       "Added",
+			Index:       index(2),
+			Replacement: "X",
+			Expected: expected{
+				Changes: &changelog.Changes{
+					Added: sliceOfStringsP([]string{"A", "B", "X"}),
+				},
+			},
+		},
+		"Missing Text": {
+			Scope:       "added",
+			Change:      "X",
+			Replacement: "Y",
+			Expected: expected{
+				Error: `change "X" not found in scope added`,
+			},
+		},
+		"Missing Index": {
+			Scope:       "removed",
+			Index:       index(0),
+			Replacement: "Y",
+			Expected: expected{
+				Error: "change 0 not found in scope removed",
+			},
+		},
+		"Empty Replacement": {
+			Scope:       "added",
+			Change:      "A",
+			Replacement: " ",
+			Expected: expected{
+				Error: "replacement must not be empty",
+			},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c := &changelog.Changes{
+			Added: sliceOfStringsP([]string{"A", "B", "B"}),
+		}
+
+		var err error
+		if test.Index != nil {
+			err = c.ReplaceChangeAt(test.Scope, *test.Index, test.Replacement)
+		} else {
+			err = c.ReplaceChange(test.Scope, test.Change, test.Replacement)
+		}
+
+		if test.Expected.Error != "" {
+			a.EqualError(err, test.Expected.Error)
+		} else {
+			a.Equal(nil, err)
+			a.Equal(test.Expected.Changes, c)
+		}
+	}
+}
+
+func TestClearScope(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Scope    string
+		Expected *changelog.Changes
+		Error    string
+	}
+
+	suite := map[string]test{
+		"Scope": {
+			Scope:    "Added",
+			Expected: &changelog.Changes{Fixed: sliceOfStringsP([]string{"B"})},
+		},
+		"Empty Scope": {
+			Scope: "Security",
+			Error: "scope security is empty",
+		},
+		"Invalid Scope": {
+			Scope: "invalid",
+			Error: "unexpected scope: invalid (supported: [added,changed,deprecated,removed,fixed,security])",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c := &changelog.Changes{
+			Added: sliceOfStringsP([]string{"A"}),
+			Fixed: sliceOfStringsP([]string{"B"}),
+		}
+
+		err := c.ClearScope(test.Scope)
+		if test.Error != "" {
+			a.EqualError(err, test.Error)
+		} else {
+			a.Equal(nil, err)
+			a.Equal(test.Expected, c)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package changelog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Chat platforms limits.
+const (
+	SlackHeaderMaxLength  int = 150
+	SlackSectionMaxLength int = 3000
+	TeamsMessageMaxSize   int = 28000
+)
+
+type slackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type     string       `json:"type"`
+	Text     *slackText   `json:"text,omitempty"`
+	Elements []*slackText `json:"elements,omitempty"`
+}
+
+type slackText struct {
+	Type  string `json:"type"`
+	Text  string `json:"text"`
+	Emoji bool   `json:"emoji,omitempty"`
+}
+
+// ChatOptions configures chat message payloads of a release.
+type ChatOptions struct {
+	// ScopeOrder defaults to DefaultScopeOrder.
+	ScopeOrder ScopeOrder
+	// PreserveScopeOrder lists scopes of parsed changes in order of their appearance in a file.
+	PreserveScopeOrder bool
+}
+
+// SlackMessage returns a Slack Block Kit message payload of a release.
+//
+// The message contains a header with a project name and a version, a context with a date and a URL,
+// and a section per scope.
+// Texts exceeding Slack limits are truncated.
+func SlackMessage(project string, r *Release) ([]byte, error) {
+	return SlackMessageWithOptions(project, r, ChatOptions{})
+}
+
+// SlackMessageWithOptions returns a Slack Block Kit message payload of a release.
+//
+// Identical to SlackMessage but with a configurable order of scopes.
+func SlackMessageWithOptions(project string, r *Release, options ChatOptions) ([]byte, error) {
+	title := chatTitle(project, r)
+	m := slackMessage{
+		Text: title,
+		Blocks: []slackBlock{
+			{
+				Type: "header",
+				Text: &slackText{Type: "plain_text", Text: truncate(title, SlackHeaderMaxLength), Emoji: true},
+			},
+		},
+	}
+
+	var context []string
+	if r.Date != nil {
+		context = append(context, formatDate(r.Date))
+	}
+	if r.Yanked {
+		context = append(context, "*YANKED*")
+	}
+	if r.URL != nil {
+		context = append(context, fmt.Sprintf("<%v|Release notes>", *r.URL))
+	}
+	if len(context) > 0 {
+		m.Blocks = append(m.Blocks, slackBlock{
+			Type:     "context",
+			Elements: []*slackText{{Type: "mrkdwn", Text: strings.Join(context, " | ")}},
+		})
+	}
+
+	if r.Changes != nil {
+		if r.Changes.Notice != nil {
+			m.Blocks = append(m.Blocks, slackSection(truncate(escapeSlack(*r.Changes.Notice), SlackSectionMaxLength)))
+		}
+
+		for _, s := range r.Changes.orderedScopes(options.ScopeOrder, options.PreserveScopeOrder) {
+			if s.entries != nil {
+				entries := make([]string, 0, len(*s.entries))
+				for _, e := range *s.entries {
+					entries = append(entries, escapeSlack(e))
+				}
+
+				m.Blocks = append(m.Blocks, slackSection(chatScope(fmt.Sprintf("*%v*", s.name), "• ", entries, SlackSectionMaxLength)))
+			}
+		}
+	}
+
+	return marshalJSON(m)
+}
+
+func slackSection(text string) slackBlock {
+	return slackBlock{
+		Type: "section",
+		Text: &slackText{Type: "mrkdwn", Text: text},
+	}
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func escapeSlack(s string) string {
+	return slackEscaper.Replace(s)
+}
+
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string    `json:"contentType"`
+	Content     teamsCard `json:"content"`
+}
+
+type teamsCard struct {
+	Schema  string        `json:"$schema"`
+	Type    string        `json:"type"`
+	Version string        `json:"version"`
+	Body    []teamsBlock  `json:"body"`
+	Actions []teamsAction `json:"actions,omitempty"`
+}
+
+type teamsBlock struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	Size     string `json:"size,omitempty"`
+	Weight   string `json:"weight,omitempty"`
+	IsSubtle bool   `json:"isSubtle,omitempty"`
+	Wrap     bool   `json:"wrap"`
+}
+
+type teamsAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// TeamsMessage returns a Microsoft Teams message payload with an Adaptive Card of a release.
+//
+// The card contains a header with a project name and a version, a date, a block per scope
+// and an action opening a release URL.
+// Entries are dropped from the end of the largest scopes until the payload fits Teams limit.
+func TeamsMessage(project string, r *Release) ([]byte, error) {
+	return TeamsMessageWithOptions(project, r, ChatOptions{})
+}
+
+// TeamsMessageWithOptions returns a Microsoft Teams message payload with an Adaptive Card of a release.
+//
+// Identical to TeamsMessage but with a configurable order of scopes.
+func TeamsMessageWithOptions(project string, r *Release, options ChatOptions) ([]byte, error) {
+	o, err := marshalJSON(teamsCardMessage(project, r, options, -1))
+	if err != nil || len(o) <= TeamsMessageMaxSize || r.Changes == nil {
+		return o, err
+	}
+
+	// NOTE: find the largest amount of entries per scope that fits the limit
+	low, high := 0, 0
+	for _, s := range r.Changes.scopes() {
+		if s.entries != nil && len(*s.entries) > high {
+			high = len(*s.entries)
+		}
+	}
+
+	for low < high {
+		middle := (low + high + 1) / 2
+
+		x, err := marshalJSON(teamsCardMessage(project, r, options, middle))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(x) <= TeamsMessageMaxSize {
+			low = middle
+		} else {
+			high = middle - 1
+		}
+	}
+
+	return marshalJSON(teamsCardMessage(project, r, options, low))
+}
+
+// teamsCardMessage builds a card including up to a limit of entries per scope, negative limit includes all entries.
+func teamsCardMessage(project string, r *Release, options ChatOptions, limit int) teamsMessage {
+	card := teamsCard{
+		Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+		Type:    "AdaptiveCard",
+		Version: "1.4",
+		Body: []teamsBlock{
+			{Type: "TextBlock", Text: chatTitle(project, r), Size: "Large", Weight: "Bolder", Wrap: true},
+		},
+	}
+
+	var context []string
+	if r.Date != nil {
+		context = append(context, formatDate(r.Date))
+	}
+	if r.Yanked {
+		context = append(context, "**YANKED**")
+	}
+	if len(context) > 0 {
+		card.Body = append(card.Body, teamsBlock{Type: "TextBlock", Text: strings.Join(context, " | "), IsSubtle: true, Wrap: true})
+	}
+
+	if r.Changes != nil {
+		if r.Changes.Notice != nil {
+			card.Body = append(card.Body, teamsBlock{Type: "TextBlock", Text: *r.Changes.Notice, Wrap: true})
+		}
+
+		for _, s := range r.Changes.orderedScopes(options.ScopeOrder, options.PreserveScopeOrder) {
+			if s.entries != nil {
+				entries := *s.entries
+				if limit >= 0 && len(entries) > limit {
+					entries = append(entries[:limit:limit], omittedEntries(len(*s.entries)-limit))
+				}
+
+				card.Body = append(card.Body,
+					teamsBlock{Type: "TextBlock", Text: s.name, Weight: "Bolder", Wrap: true},
+					teamsBlock{Type: "TextBlock", Text: chatScope("", "- ", entries, 0), Wrap: true},
+				)
+			}
+		}
+	}
+
+	if r.URL != nil {
+		card.Actions = []teamsAction{{Type: "Action.OpenUrl", Title: "Release notes", URL: *r.URL}}
+	}
+
+	return teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{
+			{ContentType: "application/vnd.microsoft.card.adaptive", Content: card},
+		},
+	}
+}
+
+// marshalJSON encodes a value without escaping HTML characters.
+func marshalJSON(v interface{}) ([]byte, error) {
+	b := new(bytes.Buffer)
+	e := json.NewEncoder(b)
+	e.SetEscapeHTML(false)
+
+	if err := e.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
+
+func chatTitle(project string, r *Release) string {
+	version := "Unreleased"
+	if r.Version != nil {
+		version = *r.Version
+	}
+
+	if project == "" {
+		return version
+	}
+
+	return fmt.Sprintf("%v %v", project, version)
+}
+
+// chatScope formats a list of entries under a title.
+// When a positive limit is exceeded, trailing entries are replaced by a counter of omitted entries.
+func chatScope(title, bullet string, entries []string, limit int) string {
+	var lines []string
+	if title != "" {
+		lines = append(lines, title)
+	}
+
+	for i, e := range entries {
+		candidate := append(lines, bullet+e)
+
+		length := utf8.RuneCountInString(strings.Join(candidate, "\n"))
+		if rest := len(entries) - i - 1; rest > 0 {
+			length += 1 + utf8.RuneCountInString(omittedEntries(rest))
+		}
+
+		if limit > 0 && length > limit {
+			lines = append(lines, omittedEntries(len(entries)-i))
+			break
+		}
+
+		lines = candidate
+	}
+
+	if limit > 0 {
+		return truncate(strings.Join(lines, "\n"), limit)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func omittedEntries(count int) string {
+	return fmt.Sprintf("…and %v more", count)
+}
+
+// truncate shortens a text to a maximum amount of characters, marking it with an ellipsis.
+func truncate(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+
+	return string([]rune(text)[:max-1]) + "…"
+}
@@ -0,0 +1,165 @@
+package changelog_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlackMessage(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Project  string
+		Release  *changelog.Release
+		Expected string
+	}
+
+	suite := map[string]test{
+		"Unreleased": {
+			Project:  "",
+			Release:  new(changelog.Release),
+			Expected: `{"text":"Unreleased","blocks":[{"type":"header","text":{"type":"plain_text","text":"Unreleased","emoji":true}}]}`,
+		},
+		"Release": {
+			Project: "go-changelog",
+			Release: &changelog.Release{
+				Version: stringP("1.0.0"),
+				Date:    dateP("2021-05-19"),
+				Yanked:  true,
+				URL:     stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0"),
+				Changes: &changelog.Changes{
+					Notice: stringP("notice"),
+					Added:  sliceOfStringsP([]string{"A", "B"}),
+					Fixed:  sliceOfStringsP([]string{"x < y & z"}),
+				},
+			},
+			Expected: `{"text":"go-changelog 1.0.0","blocks":[` +
+				`{"type":"header","text":{"type":"plain_text","text":"go-changelog 1.0.0","emoji":true}},` +
+				`{"type":"context","elements":[{"type":"mrkdwn","text":"2021-05-19 | *YANKED* | <https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0|Release notes>"}]},` +
+				`{"type":"section","text":{"type":"mrkdwn","text":"notice"}},` +
+				`{"type":"section","text":{"type":"mrkdwn","text":"*Added*\n• A\n• B"}},` +
+				`{"type":"section","text":{"type":"mrkdwn","text":"*Fixed*\n• x &lt; y &amp; z"}}]}`,
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		o, err := changelog.SlackMessage(test.Project, test.Release)
+		a.Equal(nil, err)
+		a.Equal(test.Expected, string(o))
+	}
+}
+
+func TestSlackMessageLimits(t *testing.T) {
+	a := assert.New(t)
+
+	entries := make([]string, 0)
+	for i := 0; i < 100; i++ {
+		entries = append(entries, fmt.Sprintf("%03d %v", i, strings.Repeat("x", 96)))
+	}
+
+	r := &changelog.Release{
+		Version: stringP("1.0.0"),
+		Changes: &changelog.Changes{
+			Notice: stringP(strings.Repeat("n", 4000)),
+			Added:  &entries,
+		},
+	}
+
+	t.Log("Test Case 1/1 - Truncated Texts")
+	o, err := changelog.SlackMessage(strings.Repeat("p", 200), r)
+	a.Equal(nil, err)
+
+	var m struct {
+		Blocks []struct {
+			Text struct {
+				Text string `json:"text"`
+			} `json:"text"`
+		} `json:"blocks"`
+	}
+	a.Equal(nil, json.Unmarshal(o, &m))
+	a.Len(m.Blocks, 3)
+
+	a.Equal(changelog.SlackHeaderMaxLength, len([]rune(m.Blocks[0].Text.Text)))
+	a.True(strings.HasSuffix(m.Blocks[0].Text.Text, "…"))
+
+	a.Equal(changelog.SlackSectionMaxLength, len([]rune(m.Blocks[1].Text.Text)))
+
+	a.LessOrEqual(len([]rune(m.Blocks[2].Text.Text)), changelog.SlackSectionMaxLength)
+	a.True(strings.HasPrefix(m.Blocks[2].Text.Text, "*Added*\n• 000 "))
+	a.True(strings.HasSuffix(m.Blocks[2].Text.Text, "\n…and 72 more"))
+}
+
+func TestTeamsMessage(t *testing.T) {
+	a := assert.New(t)
+
+	t.Log("Test Case 1/2 - Release")
+	o, err := changelog.TeamsMessage("go-changelog", &changelog.Release{
+		Version: stringP("1.0.0"),
+		Date:    dateP("2021-05-19"),
+		URL:     stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0"),
+		Changes: &changelog.Changes{
+			Notice:  stringP("notice"),
+			Removed: sliceOfStringsP([]string{"A", "B"}),
+		},
+	})
+	a.Equal(nil, err)
+	a.Equal(`{"type":"message","attachments":[{"contentType":"application/vnd.microsoft.card.adaptive","content":{`+
+		`"$schema":"http://adaptivecards.io/schemas/adaptive-card.json","type":"AdaptiveCard","version":"1.4","body":[`+
+		`{"type":"TextBlock","text":"go-changelog 1.0.0","size":"Large","weight":"Bolder","wrap":true},`+
+		`{"type":"TextBlock","text":"2021-05-19","isSubtle":true,"wrap":true},`+
+		`{"type":"TextBlock","text":"notice","wrap":true},`+
+		`{"type":"TextBlock","text":"Removed","weight":"Bolder","wrap":true},`+
+		`{"type":"TextBlock","text":"- A\n- B","wrap":true}],`+
+		`"actions":[{"type":"Action.OpenUrl","title":"Release notes","url":"https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0"}]}}]}`,
+		string(o))
+
+	t.Log("Test Case 2/2 - Size Limit")
+	entries := make([]string, 0)
+	for i := 0; i < 500; i++ {
+		entries = append(entries, strings.Repeat("x", 100))
+	}
+
+	o, err = changelog.TeamsMessage("", &changelog.Release{
+		Version: stringP("1.0.0"),
+		Changes: &changelog.Changes{
+			Fixed: &entries,
+		},
+	})
+	a.Equal(nil, err)
+	a.LessOrEqual(len(o), changelog.TeamsMessageMaxSize)
+	a.Contains(string(o), "…and ")
+	a.Len(entries, 500)
+}
+
+func TestChatMessagesScopeOrder(t *testing.T) {
+	a := assert.New(t)
+
+	r := &changelog.Release{
+		Version: stringP("1.0.0"),
+		Changes: &changelog.Changes{
+			Added:    sliceOfStringsP([]string{"A"}),
+			Security: sliceOfStringsP([]string{"B"}),
+		},
+	}
+	options := changelog.ChatOptions{ScopeOrder: changelog.KeepAChangelogScopeOrder}
+
+	t.Log("Test Case 1/2 - Slack")
+	o, err := changelog.SlackMessageWithOptions("", r, options)
+	a.Equal(nil, err)
+	a.Less(strings.Index(string(o), "*Added*"), strings.Index(string(o), "*Security*"))
+
+	t.Log("Test Case 2/2 - Teams")
+	o, err = changelog.TeamsMessageWithOptions("", r, options)
+	a.Equal(nil, err)
+	a.Less(strings.Index(string(o), `"text":"Added"`), strings.Index(string(o), `"text":"Security"`))
+}
@@ -0,0 +1,62 @@
+package changelog
+
+import (
+	"time"
+)
+
+// Clock provides a current time of release dates.
+//
+// Replace it for deterministic dates, for example in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to a Clock interface.
+type ClockFunc func() time.Time
+
+// Now returns a current time.
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// SystemClock is a Clock of a system time.
+var SystemClock Clock = ClockFunc(time.Now)
+
+// Today returns a current date (YYYY-MM-DD) according to Changelog.Clock in Changelog.Location.
+//
+// Defaults are SystemClock and UTC.
+func (c *Changelog) Today() string {
+	clock := c.Clock
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	location := c.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	return clock.Now().In(location).Format(DateFormat)
+}
+
+// CreateReleaseToday creates new empty release dated today.
+//
+// Identical to CreateRelease but with a date returned by Today.
+func (c *Changelog) CreateReleaseToday(version string) (*Release, error) {
+	return c.CreateRelease(version, c.Today())
+}
+
+// CreateReleaseFromUnreleasedToday creates a new release dated today with all the changes from Unreleased section.
+// This will also cleanup the Unreleased section.
+//
+// Identical to CreateReleaseFromUnreleased but with a date returned by Today.
+func (c *Changelog) CreateReleaseFromUnreleasedToday(version string) (*Release, error) {
+	return c.CreateReleaseFromUnreleased(version, c.Today())
+}
+
+// ReleaseNextToday creates a new release dated today using a version inferred by NextVersion.
+//
+// Identical to ReleaseNext but with a date returned by Today.
+func (c *Changelog) ReleaseNextToday() (*Release, error) {
+	return c.ReleaseNext(c.Today())
+}
@@ -0,0 +1,105 @@
+package changelog_test
+
+import (
+	"testing"
+	"time"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/stretchr/testify/assert"
+)
+
+func fixedClock(t time.Time) changelog.Clock {
+	return changelog.ClockFunc(func() time.Time {
+		return t
+	})
+}
+
+func TestToday(t *testing.T) {
+	a := assert.New(t)
+
+	now := time.Date(2021, 5, 19, 23, 30, 0, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*60*60)
+	newYork := time.FixedZone("EDT", -4*60*60)
+
+	type test struct {
+		Changelog *changelog.Changelog
+		Expected  string
+	}
+
+	suite := map[string]test{
+		"UTC": {
+			Changelog: &changelog.Changelog{Clock: fixedClock(now)},
+			Expected:  "2021-05-19",
+		},
+		"UTC From Another Zone": {
+			Changelog: &changelog.Changelog{Clock: fixedClock(now.In(tokyo))},
+			Expected:  "2021-05-19",
+		},
+		"Ahead Of UTC": {
+			Changelog: &changelog.Changelog{Clock: fixedClock(now), Location: tokyo},
+			Expected:  "2021-05-20",
+		},
+		"Behind UTC": {
+			Changelog: &changelog.Changelog{Clock: fixedClock(now.Add(-22 * time.Hour)), Location: newYork},
+			Expected:  "2021-05-18",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		a.Equal(test.Expected, test.Changelog.Today())
+	}
+}
+
+func TestTodaySystemClock(t *testing.T) {
+	a := assert.New(t)
+
+	t.Log("Test Case 1/1 - Default")
+	before := time.Now().UTC().Format(changelog.DateFormat)
+	today := changelog.NewChangelog().Today()
+	after := time.Now().UTC().Format(changelog.DateFormat)
+
+	a.Contains([]string{before, after}, today)
+}
+
+func TestCreateReleaseToday(t *testing.T) {
+	a := assert.New(t)
+
+	clock := fixedClock(time.Date(2021, 5, 19, 12, 0, 0, 0, time.UTC))
+
+	t.Log("Test Case 1/4 - Create Release")
+	c := changelog.NewChangelog()
+	c.Clock = clock
+
+	r, err := c.CreateReleaseToday("1.0.0")
+	a.Equal(nil, err)
+	a.Equal(dateP("2021-05-19"), r.Date)
+
+	t.Log("Test Case 2/4 - Invalid Version")
+	r, err = c.CreateReleaseToday("x")
+	a.EqualError(err, "invalid semantic version x, expected to match regex "+changelog.SemVerRegex)
+	a.Nil(r)
+
+	t.Log("Test Case 3/4 - Create Release From Unreleased")
+	if err := c.AddUnreleasedChange("fixed", "A"); err != nil {
+		t.Fatalf("error preparing test case: %v", err)
+	}
+
+	r, err = c.CreateReleaseFromUnreleasedToday("1.0.1")
+	a.Equal(nil, err)
+	a.Equal(dateP("2021-05-19"), r.Date)
+	a.Equal(&changelog.Changes{Fixed: sliceOfStringsP([]string{"A"})}, r.Changes)
+
+	t.Log("Test Case 4/4 - Release Next")
+	if err := c.AddUnreleasedChange("added", "B"); err != nil {
+		t.Fatalf("error preparing test case: %v", err)
+	}
+
+	r, err = c.ReleaseNextToday()
+	a.Equal(nil, err)
+	a.Equal(stringP("1.1.0"), r.Version)
+	a.Equal(dateP("2021-05-19"), r.Date)
+}
@@ -0,0 +1,75 @@
+// Command changelog-fmt rewrites changelog files in their canonical form.
+//
+// Usage:
+//
+//	changelog-fmt [flags] [file ...]
+//
+// Files default to CHANGELOG.md. In check mode files are not modified,
+// a unified diff is printed for every unformatted file and the command exits with status 1.
+// Files with content that formatting would drop, such as unsupported headings, are reported
+// and the command exits with status 2.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/anton-yurchenko/go-changelog/cmd/internal/cli"
+	"github.com/spf13/afero"
+)
+
+func main() {
+	check := flag.Bool("check", false, "report unformatted files without modifying them")
+	markdownFlags := cli.MarkdownFlags(flag.CommandLine)
+	flag.Parse()
+
+	markdown, err := markdownFlags()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	files := flag.Args()
+	if len(files) == 0 {
+		files = []string{"CHANGELOG.md"}
+	}
+
+	os.Exit(run(afero.NewOsFs(), os.Stdout, files, markdown, *check))
+}
+
+// run formats files and writes diffs (check mode) or names of formatted files to an output.
+func run(fs afero.Fs, output io.Writer, files []string, markdown *changelog.MarkdownRenderer, check bool) int {
+	status := 0
+
+	for _, file := range files {
+		var result *changelog.FormatResult
+		var err error
+
+		if check {
+			result, err = changelog.CheckFormat(fs, file, markdown)
+		} else {
+			result, err = changelog.Format(fs, file, markdown)
+		}
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v: %v\n", file, err)
+			return 2
+		}
+
+		if !result.Changed {
+			continue
+		}
+
+		if check {
+			fmt.Fprint(output, result.Diff)
+			status = 1
+		} else {
+			fmt.Fprintln(output, file)
+		}
+	}
+
+	return status
+}
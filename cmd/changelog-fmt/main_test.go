@@ -0,0 +1,165 @@
+package main
+
+import (
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/anton-yurchenko/go-changelog/cmd/internal/cli"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	a := assert.New(t)
+
+	type expected struct {
+		Status  int
+		Output  string
+		Content string
+	}
+
+	type test struct {
+		Content  *string
+		Flags    []string
+		Check    bool
+		Expected expected
+	}
+
+	formatted := "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Changed\n\n- A\n\n### Added\n\n- B\n"
+	keepAChangelog := "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- B\n\n### Changed\n\n- A\n"
+
+	suite := map[string]test{
+		"Formatted": {
+			Content: &formatted,
+			Check:   true,
+			Expected: expected{
+				Status:  0,
+				Content: formatted,
+			},
+		},
+		"Check Unformatted": {
+			Content: &keepAChangelog,
+			Check:   true,
+			Expected: expected{
+				Status:  1,
+				Output:  "--- a/CHANGELOG.md\n+++ b/CHANGELOG.md\n@@ -2,10 +2,10 @@\n \n ## [1.0.0] - 2021-05-19\n \n-### Added\n-\n-- B\n-\n ### Changed\n \n - A\n+\n+### Added\n+\n+- B\n",
+				Content: keepAChangelog,
+			},
+		},
+		"Format Unformatted": {
+			Content: &keepAChangelog,
+			Expected: expected{
+				Status:  0,
+				Output:  "CHANGELOG.md\n",
+				Content: formatted,
+			},
+		},
+		"Keep a Changelog Scope Order": {
+			Content: &keepAChangelog,
+			Flags:   []string{"-scope-order", "keepachangelog"},
+			Check:   true,
+			Expected: expected{
+				Status:  0,
+				Content: keepAChangelog,
+			},
+		},
+		"Preserved Scope Order": {
+			Content: &keepAChangelog,
+			Flags:   []string{"-scope-order", "preserve"},
+			Check:   true,
+			Expected: expected{
+				Status:  0,
+				Content: keepAChangelog,
+			},
+		},
+		"Custom Format": {
+			Content: &formatted,
+			Flags:   []string{"-bullet", "*", "-blank-lines", "2", "-compact-headings", "-trailing-newline"},
+			Expected: expected{
+				Status:  0,
+				Output:  "CHANGELOG.md\n",
+				Content: "# Changelog\n\n\n## [1.0.0] - 2021-05-19\n### Changed\n* A\n\n\n### Added\n* B\n",
+			},
+		},
+		"Unrecognized Content": {
+			Content: stringP(formatted + "\n### Notes\n\nUpgrade carefully.\n"),
+			Expected: expected{
+				Status:  2,
+				Content: formatted + "\n### Notes\n\nUpgrade carefully.\n",
+			},
+		},
+		"Missing File": {
+			Check: true,
+			Expected: expected{
+				Status: 2,
+			},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		f := flag.NewFlagSet("changelog-fmt", flag.ContinueOnError)
+		markdownFlags := cli.MarkdownFlags(f)
+		if err := f.Parse(test.Flags); err != nil {
+			t.Fatalf("error preparing test case: %v", err)
+		}
+
+		markdown, err := markdownFlags()
+		if err != nil {
+			t.Fatalf("error preparing test case: %v", err)
+		}
+
+		fs := afero.NewMemMapFs()
+		if test.Content != nil {
+			if err := afero.WriteFile(fs, "CHANGELOG.md", []byte(*test.Content), 0644); err != nil {
+				t.Fatalf("error preparing test case: %v", err)
+			}
+		}
+
+		output := new(strings.Builder)
+		a.Equal(test.Expected.Status, run(fs, output, []string{"CHANGELOG.md"}, markdown, test.Check))
+		a.Equal(test.Expected.Output, output.String())
+
+		if test.Content != nil {
+			content, err := afero.ReadFile(fs, "CHANGELOG.md")
+			a.Equal(nil, err)
+			a.Equal(test.Expected.Content, string(content))
+		}
+	}
+}
+
+func TestMarkdownFlags(t *testing.T) {
+	a := assert.New(t)
+
+	suite := map[string][]string{
+		"Invalid Bullet":      {"-bullet", "#"},
+		"Invalid Links":       {"-links", "footnote"},
+		"Invalid Order":       {"-order", "alphabetical"},
+		"Invalid Scope Order": {"-scope-order", "alphabetical"},
+		"Invalid Blank Lines": {"-blank-lines", "0"},
+	}
+
+	var counter int
+	for name, args := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		f := flag.NewFlagSet("changelog-fmt", flag.ContinueOnError)
+		markdownFlags := cli.MarkdownFlags(f)
+		if err := f.Parse(args); err != nil {
+			t.Fatalf("error preparing test case: %v", err)
+		}
+
+		markdown, err := markdownFlags()
+		a.Error(err)
+		a.Nil(markdown)
+	}
+}
+
+func stringP(v string) *string {
+	return &v
+}
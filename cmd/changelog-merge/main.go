@@ -0,0 +1,121 @@
+// Command changelog-merge is a Git merge driver for changelog files.
+//
+// Usage:
+//
+//	changelog-merge [flags] base current other [path]
+//
+// The driver merges Unreleased sections and releases of all three versions of a file
+// and writes the result in its canonical form to the current file.
+// Formatting flags are the same as of changelog-fmt, so merged files pass its check with the same flags.
+// An optional path is a name of the file used in messages.
+// Conflicting values are surrounded with conflict markers and the command exits with status 1,
+// errors (for example: an unparsable file or content that merging would drop, such as unsupported headings)
+// leave the current file untouched and exit with status 2, so Git falls back to a textual merge.
+//
+// Register the driver in a Git configuration:
+//
+//	git config merge.changelog.name "changelog merge driver"
+//	git config merge.changelog.driver "changelog-merge %O %A %B %P"
+//
+// And assign it to changelog files in .gitattributes:
+//
+//	CHANGELOG.md merge=changelog
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/anton-yurchenko/go-changelog/cmd/internal/cli"
+	"github.com/spf13/afero"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %v [flags] base current other [path]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	markdownFlags := cli.MarkdownFlags(flag.CommandLine)
+	flag.Parse()
+
+	if flag.NArg() != 3 && flag.NArg() != 4 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	markdown, err := markdownFlags()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	name := flag.Arg(1)
+	if flag.NArg() == 4 {
+		name = flag.Arg(3)
+	}
+
+	os.Exit(run(afero.NewOsFs(), flag.Arg(0), flag.Arg(1), flag.Arg(2), name, markdown))
+}
+
+func run(fs afero.Fs, basePath, currentPath, otherPath, name string, markdown *changelog.MarkdownRenderer) int {
+	var files []*changelog.Changelog
+
+	versions := []string{"base", "current", "other"}
+
+	for i, file := range []string{basePath, currentPath, otherPath} {
+		c, err := parse(fs, file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v (%v): %v\n", name, versions[i], err)
+			return 2
+		}
+
+		if c == nil && i > 0 {
+			c = new(changelog.Changelog)
+		}
+
+		files = append(files, c)
+	}
+
+	merged, conflicts := changelog.Merge(files[0], files[1], files[2])
+	merged.Markdown = markdown
+	if len(conflicts) == 0 {
+		if err := merged.SaveToFile(fs, currentPath); err != nil {
+			fmt.Fprintf(os.Stderr, "%v: %v\n", name, err)
+			return 2
+		}
+
+		return 0
+	}
+
+	if err := afero.WriteFile(fs, currentPath, []byte(changelog.ConflictMarkers(merged, files[2], conflicts)), 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "%v: %v\n", name, err)
+		return 2
+	}
+
+	for _, c := range conflicts {
+		fmt.Fprintf(os.Stderr, "%v: %v\n", name, c)
+	}
+
+	return 1
+}
+
+// parse returns a strictly parsed changelog, or nil for an empty file (for example: a base of files added on both sides).
+func parse(fs afero.Fs, file string) (*changelog.Changelog, error) {
+	info, err := fs.Stat(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Size() == 0 {
+		return nil, nil
+	}
+
+	p, err := changelog.NewParserWithFilesystem(fs, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.ParseStrict()
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	a := assert.New(t)
+
+	type expected struct {
+		Status  int
+		Content string
+	}
+
+	type test struct {
+		Base     string
+		Current  string
+		Other    string
+		Markdown *changelog.MarkdownRenderer
+		Expected expected
+	}
+
+	suite := map[string]test{
+		"Clean Merge": {
+			Base:    "# Changelog\n\n## [Unreleased]\n",
+			Current: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n",
+			Other:   "# Changelog\n\n## [Unreleased]\n\n### Fixed\n\n- B\n",
+			Expected: expected{
+				Status:  0,
+				Content: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n\n### Fixed\n\n- B\n",
+			},
+		},
+		"Custom Format": {
+			Base:    "# Changelog\n\n## [Unreleased]\n\n[Unreleased]: https://github.com/o/r/compare/v1.0.0...HEAD",
+			Current: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n\n[Unreleased]: https://github.com/o/r/compare/v1.0.0...HEAD",
+			Other:   "# Changelog\n\n## [Unreleased]\n\n### Fixed\n\n- B\n\n[Unreleased]: https://github.com/o/r/compare/v1.0.0...HEAD",
+			Markdown: &changelog.MarkdownRenderer{
+				Format: changelog.FormatOptions{Bullet: "*", TrailingNewline: true},
+			},
+			Expected: expected{
+				Status:  0,
+				Content: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n* A\n\n### Fixed\n\n* B\n\n[Unreleased]: https://github.com/o/r/compare/v1.0.0...HEAD\n",
+			},
+		},
+		"Conflict": {
+			Base:    "# Changelog\n\n## [Unreleased]\n",
+			Current: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n",
+			Other:   "# Changelog\n\n## [1.0.0] - 2021-05-20\n\n### Added\n\n- A\n",
+			Expected: expected{
+				Status:  1,
+				Content: "# Changelog\n\n<<<<<<< ours\n## [1.0.0] - 2021-05-19\n=======\n## [1.0.0] - 2021-05-20\n>>>>>>> theirs\n\n### Added\n\n- A\n",
+			},
+		},
+		"Unparsable Input": {
+			Base:    "# Changelog\n\n## [Unreleased]\n",
+			Current: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n",
+			Other:   "# Changelog\n\n## [Unreleased]\n\n### Fixed\n\n- " + strings.Repeat("B", 70000) + "\n",
+			Expected: expected{
+				Status:  2,
+				Content: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n",
+			},
+		},
+		"Unrecognized Content": {
+			Base:    "# Changelog\n\n## [Unreleased]\n\n## [1.0.0] - 2021-05-19\n\n### Fixed\n\n- A\n\n### Notes\n\nUpgrade carefully.\n",
+			Current: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- B\n\n## [1.0.0] - 2021-05-19\n\n### Fixed\n\n- A\n\n### Notes\n\nUpgrade carefully.\n",
+			Other:   "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- C\n\n## [1.0.0] - 2021-05-19\n\n### Fixed\n\n- A\n\n### Notes\n\nUpgrade carefully.\n",
+			Expected: expected{
+				Status:  2,
+				Content: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- B\n\n## [1.0.0] - 2021-05-19\n\n### Fixed\n\n- A\n\n### Notes\n\nUpgrade carefully.\n",
+			},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		fs := afero.NewMemMapFs()
+		for file, content := range map[string]string{"base": test.Base, "current": test.Current, "other": test.Other} {
+			if err := afero.WriteFile(fs, file, []byte(content), 0644); err != nil {
+				t.Fatalf("error preparing test case: %v", err)
+			}
+		}
+
+		a.Equal(test.Expected.Status, run(fs, "base", "current", "other", "CHANGELOG.md", test.Markdown))
+
+		content, err := afero.ReadFile(fs, "current")
+		a.Equal(nil, err)
+		a.Equal(test.Expected.Content, string(content))
+
+		if test.Expected.Status == 0 {
+			result, err := changelog.CheckFormat(fs, "current", test.Markdown)
+			a.Equal(nil, err)
+			a.Equal(false, result.Changed)
+		}
+	}
+}
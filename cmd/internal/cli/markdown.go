@@ -0,0 +1,82 @@
+// Package cli contains command line options shared by changelog commands.
+package cli
+
+import (
+	"flag"
+	"fmt"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/pkg/errors"
+)
+
+// MarkdownFlags registers options of a Markdown renderer on a flag set,
+// so that every command renders changelogs in the same canonical form.
+// Defaults of the options match the defaults of the library.
+//
+// The returned function builds a renderer from parsed flags.
+func MarkdownFlags(f *flag.FlagSet) func() (*changelog.MarkdownRenderer, error) {
+	var defaults changelog.FormatOptions
+
+	bullet := f.String("bullet", "-", "list item marker: -, * or +")
+	width := f.Int("width", defaults.MaxWidth, "wrap entries at a provided width, 0 disables wrapping")
+	links := f.String("links", "reference", "release links style: reference, inline or preserve")
+	order := f.String("order", "semver", "releases order: semver, date or source")
+	scopeOrder := f.String("scope-order", "default", "scopes order: default, keepachangelog, common-changelog or preserve")
+	blankLines := f.Int("blank-lines", 1, "amount of empty lines between blocks, at least 1")
+	compactHeadings := f.Bool("compact-headings", defaults.CompactHeadings, "omit empty lines between headings and their content")
+	trailingNewline := f.Bool("trailing-newline", defaults.TrailingNewline, "end files with a newline character")
+
+	return func() (*changelog.MarkdownRenderer, error) {
+		style, ok := map[string]changelog.LinkStyle{
+			"reference": changelog.ReferenceLinks,
+			"inline":    changelog.InlineLinks,
+			"preserve":  changelog.PreserveLinks,
+		}[*links]
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("unexpected links style: %v", *links))
+		}
+
+		releaseOrder, ok := map[string]changelog.ReleaseOrder{
+			"semver": changelog.SemVerOrder,
+			"date":   changelog.DateOrder,
+			"source": changelog.SourceOrder,
+		}[*order]
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("unexpected releases order: %v", *order))
+		}
+
+		scopes, ok := map[string]changelog.ScopeOrder{
+			"default":          changelog.DefaultScopeOrder,
+			"keepachangelog":   changelog.KeepAChangelogScopeOrder,
+			"common-changelog": changelog.CommonChangelogScopeOrder,
+			"preserve":         changelog.DefaultScopeOrder,
+		}[*scopeOrder]
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("unexpected scopes order: %v", *scopeOrder))
+		}
+
+		if *blankLines < 1 {
+			return nil, errors.New(fmt.Sprintf("unexpected amount of blank lines: %v", *blankLines))
+		}
+
+		markdown := &changelog.MarkdownRenderer{
+			ScopeOrder:         scopes,
+			PreserveScopeOrder: *scopeOrder == "preserve",
+			LinkStyle:          style,
+			ReleaseOrder:       releaseOrder,
+			Format: changelog.FormatOptions{
+				Bullet:          *bullet,
+				BlankLines:      *blankLines,
+				CompactHeadings: *compactHeadings,
+				MaxWidth:        *width,
+				TrailingNewline: *trailingNewline,
+			},
+		}
+
+		if err := markdown.Format.Validate(); err != nil {
+			return nil, err
+		}
+
+		return markdown, nil
+	}
+}
@@ -23,4 +23,17 @@ const (
 	FixedScopeRegex      string = `^### (?P<scope>Fixed)$`
 	SecurityScopeRegex   string = `^### (?P<scope>Security)$`
 	EntryRegex           string = `^(?P<marker>[-*+]\s*)(?P<entry>.*)$`
+	YankReasonRegex      string = `^> Yanked: (?P<reason>.+)$`
+	BreakingChangeRegex  string = `^(?i)\*\*breaking(?: change)?:?\*\*`
+	// Debian
+	DebianDateFormat       string = `Mon, 02 Jan 2006 15:04:05 -0700`
+	DebianPackageRegex     string = `[a-z0-9][a-z0-9+.-]+`
+	DebianTitleRegex       string = `^(?P<package>` + DebianPackageRegex + `) \((?P<version>[^)]+)\) (?P<distribution>[^;]+);(?P<options>.*)$`
+	DebianMaintainersRegex string = `^  \[ (?P<maintainer>.+) \]$`
+	DebianEntryRegex       string = `^  \* (?P<entry>.*)$`
+	DebianEntryLineRegex   string = `^    (?P<line>.*)$`
+	DebianTrailerRegex     string = `^ -- (?P<maintainer>.+?)  (?P<date>.+)$`
+	DebianScopeRegex       string = `^(?P<scope>Notice|Added|Changed|Deprecated|Removed|Fixed|Security): `
+	// RPM
+	RPMDateFormat string = `Mon Jan 02 2006`
 )
@@ -0,0 +1,316 @@
+package changelog
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// DebianRenderer renders releases of a changelog in a Debian changelog format (debian/changelog).
+//
+// Every entry is prefixed by its scope, for example: "  * Fixed: entry".
+// Notices are rendered as entries prefixed by "Notice".
+// Unreleased section is omitted.
+type DebianRenderer struct {
+	// Package is a name of a source package.
+	Package string
+	// Distribution defaults to "unstable".
+	Distribution string
+	// Urgency defaults to "medium".
+	Urgency string
+	// Maintainer is expected in "Full Name <email>" format.
+	Maintainer string
+	// Revision is a Debian revision appended to every version, defaults to "1".
+	Revision string
+	// ScopeOrder defaults to DefaultScopeOrder.
+	ScopeOrder ScopeOrder
+	// PreserveScopeOrder renders scopes of parsed changes in order of their appearance in a file.
+	PreserveScopeOrder bool
+}
+
+// RenderChangelog writes all releases sorted by their Semantic Version in a descending order.
+func (d *DebianRenderer) RenderChangelog(w io.Writer, c *Changelog) error {
+	for i, r := range c.Releases.descending() {
+		if i != 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+
+		if err := d.RenderRelease(w, r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RenderRelease writes a single changelog entry of a release.
+func (d *DebianRenderer) RenderRelease(w io.Writer, r *Release) error {
+	if d.Package == "" {
+		return errors.New("missing package name")
+	}
+
+	if d.Maintainer == "" {
+		return errors.New("missing maintainer")
+	}
+
+	if r.Version == nil {
+		return errors.New("missing release version")
+	}
+
+	if r.Date == nil {
+		return errors.New(fmt.Sprintf("missing date of release %v", *r.Version))
+	}
+
+	if !regexp.MustCompile(`^` + DebianPackageRegex + `$`).MatchString(d.Package) {
+		return errors.New(fmt.Sprintf("invalid package name %v, expected to match regex %v", d.Package, DebianPackageRegex))
+	}
+
+	title := fmt.Sprintf("%v (%v) %v; urgency=%v",
+		d.Package,
+		packageVersion(*r.Version, valueOrDefault(d.Revision, "1")),
+		valueOrDefault(d.Distribution, "unstable"),
+		valueOrDefault(d.Urgency, "medium"),
+	)
+	if !regexp.MustCompile(DebianTitleRegex).MatchString(title) {
+		return errors.New(fmt.Sprintf("invalid title line %v, expected to match regex %v", title, DebianTitleRegex))
+	}
+
+	var o []string
+	o = append(o, title+"\n")
+
+	if r.Changes != nil {
+		o = append(o, d.changes(r.Changes))
+	}
+
+	o = append(o, fmt.Sprintf(" -- %v  %v\n", d.Maintainer, r.Date.Format(DebianDateFormat)))
+
+	_, err := io.WriteString(w, strings.Join(o, "\n"))
+	return err
+}
+
+// RenderChanges writes entries of changes.
+func (d *DebianRenderer) RenderChanges(w io.Writer, c *Changes) error {
+	_, err := io.WriteString(w, d.changes(c))
+	return err
+}
+
+func (d *DebianRenderer) changes(c *Changes) string {
+	var o []string
+
+	if c.Notice != nil {
+		o = append(o, debianEntry(fmt.Sprintf("Notice: %v", *c.Notice)))
+	}
+
+	for _, s := range c.orderedScopes(d.ScopeOrder, d.PreserveScopeOrder) {
+		if s.entries != nil {
+			for _, e := range *s.entries {
+				o = append(o, debianEntry(fmt.Sprintf("%v: %v", s.name, e)))
+			}
+		}
+	}
+
+	if len(o) == 0 {
+		return ""
+	}
+
+	return strings.Join(o, "\n") + "\n"
+}
+
+func debianEntry(entry string) string {
+	lines := strings.Split(entry, "\n")
+	for i, l := range lines {
+		if i == 0 {
+			lines[i] = fmt.Sprintf("  * %v", l)
+		} else {
+			lines[i] = fmt.Sprintf("    %v", l)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// packageVersion converts a Semantic Version into a version of Debian and RPM packages.
+// A prerelease is separated by "~" so it sorts before the final release.
+func packageVersion(version, revision string) string {
+	return fmt.Sprintf("%v-%v", strings.Replace(version, "-", "~", 1), revision)
+}
+
+// semanticVersion converts a Debian version into a Semantic Version,
+// dropping an epoch and a Debian revision.
+func semanticVersion(version string) string {
+	if i := strings.Index(version, ":"); i != -1 {
+		version = version[i+1:]
+	}
+
+	if i := strings.LastIndex(version, "-"); i != -1 {
+		version = version[:i]
+	}
+
+	return strings.Replace(version, "~", "-", 1)
+}
+
+func valueOrDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
+
+// DebianParser is a runtime that holds a raw Debian changelog content and a filesystem backend.
+type DebianParser struct {
+	Filepath   string
+	Filesystem Filesystem
+	Buffer     []string
+}
+
+// NewDebianParser creates a new Debian changelog Parser.
+func NewDebianParser(filepath string) (*DebianParser, error) {
+	return NewDebianParserWithFilesystem(afero.NewOsFs(), filepath)
+}
+
+// NewDebianParserWithFilesystem creates a new Debian changelog Parser using non default (OS) filesystem.
+//
+// Possible options for Filesystem are: [afero.NewOsFs(), afero.NewMemMapFs()].
+func NewDebianParserWithFilesystem(filesystem Filesystem, filepath string) (*DebianParser, error) {
+	_, err := filesystem.Stat(filepath)
+	if os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "file %v not found", filepath)
+	}
+
+	return &DebianParser{
+		Filepath:   filepath,
+		Filesystem: filesystem}, nil
+}
+
+// Parse a Debian changelog file and return a Changelog struct.
+//
+// Entries prefixed by a scope or a notice (as written by DebianRenderer) are placed in their scopes or a notice,
+// any other entries are considered as changed.
+// Names of co-maintainers (for example: "  [ Full Name ]") are omitted,
+// any other unrecognized line is reported as an error.
+func (p *DebianParser) Parse() (*Changelog, error) {
+	if err := p.loadBuffer(); err != nil {
+		return nil, errors.Wrap(err, "error loading a buffer")
+	}
+
+	o := NewChangelog()
+
+	title := regexp.MustCompile(DebianTitleRegex)
+	entry := regexp.MustCompile(DebianEntryRegex)
+	entryLine := regexp.MustCompile(DebianEntryLineRegex)
+	trailer := regexp.MustCompile(DebianTrailerRegex)
+	maintainers := regexp.MustCompile(DebianMaintainersRegex)
+	empty := regexp.MustCompile(EmptyLineRegex)
+
+	var release *Release
+	var entries []string
+
+	for i, l := range p.Buffer {
+		switch {
+		case title.MatchString(l):
+			if release != nil {
+				return nil, errors.New(fmt.Sprintf("missing trailer line of release %v", *release.Version))
+			}
+
+			v := semanticVersion(title.ReplaceAllString(l, "${version}"))
+			r := new(Release)
+			if err := r.SetVersion(v); err != nil {
+				return nil, errors.Wrapf(err, "error parsing line %v", i+1)
+			}
+
+			r.Changes = new(Changes)
+			release = r
+			entries = make([]string, 0)
+		case release == nil && empty.MatchString(l):
+			continue
+		case release == nil:
+			return nil, errors.New(fmt.Sprintf("unexpected line %v: %v", i+1, l))
+		case entry.MatchString(l):
+			entries = append(entries, entry.ReplaceAllString(l, "${entry}"))
+		case entryLine.MatchString(l) && len(entries) > 0:
+			entries[len(entries)-1] = fmt.Sprintf("%v\n%v", entries[len(entries)-1], entryLine.ReplaceAllString(l, "${line}"))
+		case maintainers.MatchString(l):
+			continue
+		case trailer.MatchString(l):
+			// NOTE: day of month may be written without a leading zero
+			d, err := time.Parse("Mon, 2 Jan 2006 15:04:05 -0700", trailer.ReplaceAllString(l, "${date}"))
+			if err != nil {
+				return nil, errors.Wrapf(err, "error parsing date at line %v", i+1)
+			}
+
+			date := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+			release.Date = &date
+
+			if err := addDebianEntries(release.Changes, entries); err != nil {
+				return nil, errors.Wrapf(err, "error parsing release %v", *release.Version)
+			}
+
+			if release.Changes.empty() {
+				release.Changes = nil
+			}
+
+			o.Releases = append(o.Releases, release)
+			release = nil
+		case empty.MatchString(l):
+			continue
+		default:
+			return nil, errors.New(fmt.Sprintf("unexpected line %v: %v", i+1, l))
+		}
+	}
+
+	if release != nil {
+		return nil, errors.New(fmt.Sprintf("missing trailer line of release %v", *release.Version))
+	}
+
+	if len(o.Releases) == 0 {
+		return nil, errors.New("missing title line")
+	}
+
+	return o, nil
+}
+
+func addDebianEntries(changes *Changes, entries []string) error {
+	m := regexp.MustCompile(DebianScopeRegex)
+
+	for _, e := range entries {
+		scope := "changed"
+		if x := m.FindStringSubmatch(e); x != nil {
+			scope = x[1]
+			e = strings.TrimPrefix(e, x[0])
+		}
+
+		if scope == "Notice" {
+			if changes.Notice != nil {
+				e = fmt.Sprintf("%v\n%v", *changes.Notice, e)
+			}
+
+			changes.Notice = &e
+			continue
+		}
+
+		if err := changes.AddChange(scope, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *DebianParser) loadBuffer() error {
+	lines, err := readLines(p.Filesystem, p.Filepath)
+	if err != nil {
+		return err
+	}
+
+	p.Buffer = lines
+	return nil
+}
@@ -0,0 +1,417 @@
+package changelog_test
+
+import (
+	"bytes"
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/anton-yurchenko/go-changelog/mocks"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebianRenderer(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Renderer  *changelog.DebianRenderer
+		Changelog *changelog.Changelog
+		Expected  string
+		Error     string
+	}
+
+	renderer := &changelog.DebianRenderer{
+		Package:    "go-changelog",
+		Maintainer: "Anton Yurchenko <anton@example.com>",
+	}
+
+	suite := map[string]test{
+		"Empty": {
+			Renderer:  renderer,
+			Changelog: changelog.NewChangelog(),
+			Expected:  "",
+		},
+		"Missing Package": {
+			Renderer: &changelog.DebianRenderer{
+				Maintainer: "Anton Yurchenko <anton@example.com>",
+			},
+			Changelog: &changelog.Changelog{
+				Releases: changelog.Releases{
+					{
+						Version: stringP("1.0.0"),
+						Date:    dateP("2021-05-19"),
+					},
+				},
+			},
+			Error: "missing package name",
+		},
+		"Missing Maintainer": {
+			Renderer: &changelog.DebianRenderer{
+				Package: "go-changelog",
+			},
+			Changelog: &changelog.Changelog{
+				Releases: changelog.Releases{
+					{
+						Version: stringP("1.0.0"),
+						Date:    dateP("2021-05-19"),
+					},
+				},
+			},
+			Error: "missing maintainer",
+		},
+		"Invalid Package": {
+			Renderer: &changelog.DebianRenderer{
+				Package:    "p",
+				Maintainer: "Anton Yurchenko <anton@example.com>",
+			},
+			Changelog: &changelog.Changelog{
+				Releases: changelog.Releases{
+					{
+						Version: stringP("1.0.0"),
+						Date:    dateP("2021-05-19"),
+					},
+				},
+			},
+			Error: "invalid package name p, expected to match regex " + changelog.DebianPackageRegex,
+		},
+		"Invalid Urgency": {
+			Renderer: &changelog.DebianRenderer{
+				Package:    "go-changelog",
+				Urgency:    "low\nhigh",
+				Maintainer: "Anton Yurchenko <anton@example.com>",
+			},
+			Changelog: &changelog.Changelog{
+				Releases: changelog.Releases{
+					{
+						Version: stringP("1.0.0"),
+						Date:    dateP("2021-05-19"),
+					},
+				},
+			},
+			Error: "invalid title line go-changelog (1.0.0-1) unstable; urgency=low\nhigh, expected to match regex " + changelog.DebianTitleRegex,
+		},
+		"Missing Date": {
+			Renderer: renderer,
+			Changelog: &changelog.Changelog{
+				Releases: changelog.Releases{
+					{
+						Version: stringP("1.0.0"),
+					},
+				},
+			},
+			Error: "missing date of release 1.0.0",
+		},
+		"Releases": {
+			Renderer: &changelog.DebianRenderer{
+				Package:      "go-changelog",
+				Distribution: "jammy",
+				Urgency:      "low",
+				Maintainer:   "Anton Yurchenko <anton@example.com>",
+				Revision:     "0ubuntu1",
+			},
+			Changelog: &changelog.Changelog{
+				Unreleased: &changelog.Release{
+					Changes: &changelog.Changes{
+						Added: sliceOfStringsP([]string{"ignored"}),
+					},
+				},
+				Releases: changelog.Releases{
+					{
+						Version: stringP("1.0.0"),
+						Date:    dateP("2021-05-19"),
+						Changes: &changelog.Changes{
+							Notice: stringP("notice"),
+							Added:  sliceOfStringsP([]string{"A", "B\nmultiline"}),
+							Fixed:  sliceOfStringsP([]string{"C"}),
+						},
+					},
+					{
+						Version: stringP("2.0.0-rc.1"),
+						Date:    dateP("2021-06-01"),
+					},
+				},
+			},
+			Expected: `go-changelog (2.0.0~rc.1-0ubuntu1) jammy; urgency=low
+
+ -- Anton Yurchenko <anton@example.com>  Tue, 01 Jun 2021 00:00:00 +0000
+
+go-changelog (1.0.0-0ubuntu1) jammy; urgency=low
+
+  * Notice: notice
+  * Added: A
+  * Added: B
+    multiline
+  * Fixed: C
+
+ -- Anton Yurchenko <anton@example.com>  Wed, 19 May 2021 00:00:00 +0000
+`,
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		b := new(bytes.Buffer)
+		err := test.Renderer.RenderChangelog(b, test.Changelog)
+		if test.Error != "" || err != nil {
+			a.EqualError(err, test.Error)
+		} else {
+			a.Equal(test.Expected, b.String())
+		}
+	}
+}
+
+func TestDebianRendererRelease(t *testing.T) {
+	a := assert.New(t)
+
+	r := &changelog.DebianRenderer{
+		Package:    "go-changelog",
+		Maintainer: "Anton Yurchenko <anton@example.com>",
+	}
+
+	t.Log("Test Case 1/3 - Defaults")
+	b := new(bytes.Buffer)
+	a.Equal(nil, r.RenderRelease(b, &changelog.Release{
+		Version: stringP("1.0.0"),
+		Date:    dateP("2021-05-19"),
+		Changes: &changelog.Changes{
+			Security: sliceOfStringsP([]string{"A"}),
+		},
+	}))
+	a.Equal(`go-changelog (1.0.0-1) unstable; urgency=medium
+
+  * Security: A
+
+ -- Anton Yurchenko <anton@example.com>  Wed, 19 May 2021 00:00:00 +0000
+`, b.String())
+
+	t.Log("Test Case 2/3 - Unreleased")
+	a.EqualError(r.RenderRelease(b, new(changelog.Release)), "missing release version")
+
+	t.Log("Test Case 3/3 - Changes")
+	b.Reset()
+	a.Equal(nil, r.RenderChanges(b, &changelog.Changes{
+		Removed: sliceOfStringsP([]string{"A"}),
+	}))
+	a.Equal("  * Removed: A\n", b.String())
+}
+
+func TestDebianParser(t *testing.T) {
+	a := assert.New(t)
+	fs := afero.NewMemMapFs()
+
+	type expected struct {
+		Result *changelog.Changelog
+		Error  string
+	}
+
+	type test struct {
+		Changelog string
+		Expected  expected
+	}
+
+	suite := map[string]test{
+		"Empty": {
+			Changelog: "",
+			Expected: expected{
+				Error: "missing title line",
+			},
+		},
+		"Releases": {
+			Changelog: `go-changelog (2.0.0~rc.1-1) unstable; urgency=medium
+
+ -- Anton Yurchenko <anton@example.com>  Tue, 01 Jun 2021 10:11:12 +0300
+
+go-changelog (1:1.0.0-0ubuntu1) jammy; urgency=low
+
+  [ Anton Yurchenko ]
+  * notice
+  * Added: A
+  * Added: B
+    multiline
+  * Fixed: C
+
+ -- Anton Yurchenko <anton@example.com>  Wed, 5 May 2021 00:00:00 +0000
+`,
+			Expected: expected{
+				Result: &changelog.Changelog{
+					Releases: changelog.Releases{
+						{
+							Version: stringP("2.0.0-rc.1"),
+							Date:    dateP("2021-06-01"),
+						},
+						{
+							Version: stringP("1.0.0"),
+							Date:    dateP("2021-05-05"),
+							Changes: &changelog.Changes{
+								Added:   sliceOfStringsP([]string{"A", "B\nmultiline"}),
+								Changed: sliceOfStringsP([]string{"notice"}),
+								Fixed:   sliceOfStringsP([]string{"C"}),
+							},
+						},
+					},
+				},
+			},
+		},
+		"Invalid Version": {
+			Changelog: `go-changelog (1.0-1) unstable; urgency=medium
+`,
+			Expected: expected{
+				Error: "error parsing line 1: invalid semantic version 1.0, expected to match regex " + changelog.SemVerRegex,
+			},
+		},
+		"Invalid Date": {
+			Changelog: `go-changelog (1.0.0-1) unstable; urgency=medium
+
+ -- Anton Yurchenko <anton@example.com>  2021-05-19
+`,
+			Expected: expected{
+				Error: `error parsing date at line 3: parsing time "2021-05-19" as "Mon, 2 Jan 2006 15:04:05 -0700": cannot parse "2021-05-19" as "Mon"`,
+			},
+		},
+		"Unexpected Line": {
+			Changelog: `Changelog
+
+go-changelog (1.0.0-1) unstable; urgency=medium
+
+ -- Anton Yurchenko <anton@example.com>  Wed, 19 May 2021 00:00:00 +0000
+`,
+			Expected: expected{
+				Error: "unexpected line 1: Changelog",
+			},
+		},
+		"Unexpected Release Line": {
+			Changelog: `go-changelog (1.0.0-1) unstable; urgency=medium
+
+  - Added: A
+
+ -- Anton Yurchenko <anton@example.com>  Wed, 19 May 2021 00:00:00 +0000
+`,
+			Expected: expected{
+				Error: "unexpected line 3:   - Added: A",
+			},
+		},
+		"Missing Trailer Before Title": {
+			Changelog: `go-changelog (1.1.0-1) unstable; urgency=medium
+
+  * Added: A
+
+go-changelog (1.0.0-1) unstable; urgency=medium
+
+ -- Anton Yurchenko <anton@example.com>  Wed, 19 May 2021 00:00:00 +0000
+`,
+			Expected: expected{
+				Error: "missing trailer line of release 1.1.0",
+			},
+		},
+		"Missing Trailer": {
+			Changelog: `go-changelog (1.0.0-1) unstable; urgency=medium
+
+  * Added: A
+`,
+			Expected: expected{
+				Error: "missing trailer line of release 1.0.0",
+			},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		if err := afero.WriteFile(fs, "changelog", []byte(test.Changelog), 0644); err != nil {
+			t.Fatalf("error preparing test case: error creating file changelog: %v", err)
+		}
+
+		p, err := changelog.NewDebianParserWithFilesystem(fs, "changelog")
+		if err != nil {
+			t.Fatalf("error preparing test case: error creating parser: %v", err)
+		}
+
+		c, err := p.Parse()
+		a.Equal(test.Expected.Result, c)
+		if test.Expected.Error != "" || err != nil {
+			a.EqualError(err, test.Expected.Error)
+		}
+	}
+}
+
+func TestDebianRoundTrip(t *testing.T) {
+	a := assert.New(t)
+	fs := afero.NewMemMapFs()
+
+	t.Log("Test Case 1/1 - Export and Import")
+
+	c := &changelog.Changelog{
+		Releases: changelog.Releases{
+			{
+				Version: stringP("1.1.0"),
+				Date:    dateP("2021-06-01"),
+				Changes: &changelog.Changes{
+					Notice:     stringP("Notice\n\nwith paragraphs"),
+					Security:   sliceOfStringsP([]string{"A"}),
+					Deprecated: sliceOfStringsP([]string{"B"}),
+				},
+			},
+			{
+				Version: stringP("1.0.0"),
+				Date:    dateP("2021-05-19"),
+				Changes: &changelog.Changes{
+					Removed: sliceOfStringsP([]string{"C"}),
+				},
+			},
+		},
+	}
+
+	r := &changelog.DebianRenderer{
+		Package:    "go-changelog",
+		Maintainer: "Anton Yurchenko <anton@example.com>",
+	}
+
+	if err := c.SaveToFileWithRenderer(fs, "changelog", r); err != nil {
+		t.Fatalf("error preparing test case: %v", err)
+	}
+
+	p, err := changelog.NewDebianParserWithFilesystem(fs, "changelog")
+	if err != nil {
+		t.Fatalf("error preparing test case: error creating parser: %v", err)
+	}
+
+	result, err := p.Parse()
+	a.Equal(nil, err)
+	a.Equal(c, result)
+}
+
+func TestNewDebianParser(t *testing.T) {
+	a := assert.New(t)
+
+	t.Log("Test Case 1/3 - Not Found")
+	p, err := changelog.NewDebianParser("debian/changelog")
+	a.Nil(p)
+	a.EqualError(err, "file debian/changelog not found: stat debian/changelog: no such file or directory")
+
+	t.Log("Test Case 2/3 - Filesystem Error")
+	m := new(mocks.Filesystem)
+	m.On("Stat", "changelog").Return(nil, nil).Once()
+	m.On("Open", "changelog").Return(nil, errors.New("reason")).Once()
+
+	p, err = changelog.NewDebianParserWithFilesystem(m, "changelog")
+	a.Equal(nil, err)
+
+	c, err := p.Parse()
+	a.Nil(c)
+	a.EqualError(err, "error loading a buffer: reason")
+
+	t.Log("Test Case 3/3 - OS Filesystem")
+	p, err = changelog.NewDebianParser("CHANGELOG.md")
+	a.Equal(nil, err)
+	a.Equal(&changelog.DebianParser{
+		Filepath:   "CHANGELOG.md",
+		Filesystem: afero.NewOsFs(),
+	}, p)
+}
@@ -0,0 +1,328 @@
+package changelog
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DifferenceKind is a kind of a difference between two versions of a changelog.
+type DifferenceKind int
+
+// Supported kinds of differences.
+const (
+	// TitleChanged is reported when a title of a changelog was changed.
+	TitleChanged DifferenceKind = iota
+	// DescriptionChanged is reported when a description of a changelog was changed.
+	DescriptionChanged
+	// ReleaseAdded is reported when a release was added, New contains the Markdown formatted release.
+	ReleaseAdded
+	// ReleaseRemoved is reported when a release was removed, Old contains the Markdown formatted release.
+	ReleaseRemoved
+	// DateChanged is reported when a date of a release was changed.
+	DateChanged
+	// ReleaseYanked is reported when a release was yanked, New contains a yank reason.
+	ReleaseYanked
+	// ReleaseUnyanked is reported when a release is no longer yanked, Old contains a yank reason.
+	ReleaseUnyanked
+	// YankReasonChanged is reported when a yank reason of a yanked release was changed.
+	YankReasonChanged
+	// URLChanged is reported when a URL of a release or the Unreleased section was changed.
+	URLChanged
+	// NoticeChanged is reported when a notice of a release or the Unreleased section was changed.
+	NoticeChanged
+	// EntryAdded is reported when an entry was added to a scope, New contains the entry.
+	EntryAdded
+	// EntryRemoved is reported when an entry was removed from a scope, Old contains the entry.
+	EntryRemoved
+	// EntryMoved is reported when an entry was moved to a scope from another scope of the same release,
+	// Old contains a name of the previous scope and New contains the entry.
+	EntryMoved
+)
+
+func (k DifferenceKind) String() string {
+	switch k {
+	case TitleChanged:
+		return "title changed"
+	case DescriptionChanged:
+		return "description changed"
+	case ReleaseAdded:
+		return "release added"
+	case ReleaseRemoved:
+		return "release removed"
+	case DateChanged:
+		return "date changed"
+	case ReleaseYanked:
+		return "release yanked"
+	case ReleaseUnyanked:
+		return "release unyanked"
+	case YankReasonChanged:
+		return "yank reason changed"
+	case URLChanged:
+		return "url changed"
+	case NoticeChanged:
+		return "notice changed"
+	case EntryAdded:
+		return "entry added"
+	case EntryRemoved:
+		return "entry removed"
+	case EntryMoved:
+		return "entry moved"
+	default:
+		return fmt.Sprintf("unknown (%d)", int(k))
+	}
+}
+
+// Difference is a single semantic difference between two versions of a changelog.
+type Difference struct {
+	Kind DifferenceKind
+	// Version of a release, empty for the Unreleased section, title and description.
+	Version string
+	// Scope of an entry (for example: Fixed), empty for differences that are not related to entries.
+	Scope string
+	// Old and New are previous and current values (empty for a missing value).
+	Old string
+	New string
+}
+
+func (d Difference) String() string {
+	var location string
+	switch {
+	case d.Kind == TitleChanged || d.Kind == DescriptionChanged:
+	case d.Version == "":
+		location = " in Unreleased"
+	default:
+		location = fmt.Sprintf(" in release %v", d.Version)
+	}
+
+	switch d.Kind {
+	case ReleaseAdded, ReleaseRemoved:
+		return fmt.Sprintf("%v: %v", d.Kind, d.Version)
+	case ReleaseYanked:
+		return fmt.Sprintf("release %v yanked: %q", d.Version, d.New)
+	case ReleaseUnyanked:
+		return fmt.Sprintf("release %v unyanked", d.Version)
+	case EntryAdded:
+		return fmt.Sprintf("%v to %v%v: %q", d.Kind, d.Scope, location, d.New)
+	case EntryRemoved:
+		return fmt.Sprintf("%v from %v%v: %q", d.Kind, d.Scope, location, d.Old)
+	case EntryMoved:
+		return fmt.Sprintf("%v from %v to %v%v: %q", d.Kind, d.Old, d.Scope, location, d.New)
+	default:
+		return fmt.Sprintf("%v%v: %q -> %q", d.Kind, location, d.Old, d.New)
+	}
+}
+
+// Changeset is a list of differences between two versions of a changelog.
+type Changeset []Difference
+
+// Filter returns differences matching a provided function.
+func (c Changeset) Filter(match func(Difference) bool) Changeset {
+	var o Changeset
+	for _, d := range c {
+		if match(d) {
+			o = append(o, d)
+		}
+	}
+
+	return o
+}
+
+// Unreleased returns differences of the Unreleased section.
+func (c Changeset) Unreleased() Changeset {
+	return c.Filter(func(d Difference) bool {
+		return d.Version == "" && d.Kind != TitleChanged && d.Kind != DescriptionChanged
+	})
+}
+
+// Released returns differences of releases (including added and removed releases).
+func (c Changeset) Released() Changeset {
+	return c.Filter(func(d Difference) bool {
+		return d.Version != ""
+	})
+}
+
+// Diff returns semantic differences between two versions of a changelog.
+//
+// Differences are ordered: title, description, Unreleased section and releases sorted by their Semantic Version
+// in a descending order. Entries are compared per scope, reordering of entries is not reported.
+func Diff(old, new *Changelog) Changeset {
+	if old == nil {
+		old = &Changelog{}
+	}
+
+	if new == nil {
+		new = &Changelog{}
+	}
+
+	d := &differ{}
+	d.value(TitleChanged, "", old.Title, new.Title)
+	d.value(DescriptionChanged, "", old.Description, new.Description)
+
+	if old.Unreleased != nil || new.Unreleased != nil {
+		d.release("", old.Unreleased, new.Unreleased)
+	}
+
+	versions := append(append(Releases{}, new.Releases...), old.Releases...)
+	sort.Stable(sort.Reverse(versions))
+
+	seen := make(map[string]bool)
+	for _, r := range versions {
+		v := *r.Version
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+
+		x, y := old.GetRelease(v), new.GetRelease(v)
+		switch {
+		case x == nil:
+			d.add(ReleaseAdded, v, "", "", releaseValue(y))
+		case y == nil:
+			d.add(ReleaseRemoved, v, "", releaseValue(x), "")
+		default:
+			d.release(v, x, y)
+		}
+	}
+
+	return d.changeset
+}
+
+// differ collects differences of two changelogs.
+type differ struct {
+	changeset Changeset
+}
+
+func (d *differ) add(kind DifferenceKind, version, scope, old, new string) {
+	d.changeset = append(d.changeset, Difference{Kind: kind, Version: version, Scope: scope, Old: old, New: new})
+}
+
+// value reports a difference of two optional values.
+func (d *differ) value(kind DifferenceKind, version string, old, new *string) {
+	if x, y := stringValue(old), stringValue(new); x != y {
+		d.add(kind, version, "", x, y)
+	}
+}
+
+// release reports differences of a release (or the Unreleased section for an empty version).
+func (d *differ) release(version string, old, new *Release) {
+	if old == nil {
+		old = &Release{}
+	}
+
+	if new == nil {
+		new = &Release{}
+	}
+
+	d.value(DateChanged, version, dateString(old.Date), dateString(new.Date))
+
+	switch {
+	case !old.Yanked && new.Yanked:
+		d.add(ReleaseYanked, version, "", "", stringValue(new.YankReason))
+	case old.Yanked && !new.Yanked:
+		d.add(ReleaseUnyanked, version, "", stringValue(old.YankReason), "")
+	case old.Yanked:
+		d.value(YankReasonChanged, version, old.YankReason, new.YankReason)
+	}
+
+	d.value(URLChanged, version, old.URL, new.URL)
+	d.changes(version, old.Changes, new.Changes)
+}
+
+// changes reports differences of a notice and entries of every scope.
+func (d *differ) changes(version string, old, new *Changes) {
+	if old == nil {
+		old = &Changes{}
+	}
+
+	if new == nil {
+		new = &Changes{}
+	}
+
+	d.value(NoticeChanged, version, old.Notice, new.Notice)
+
+	added := make(map[string][]string)
+	removed := make(map[string][]string)
+	for _, s := range DefaultScopeOrder {
+		x, _ := old.scopeField(s)
+		y, _ := new.scopeField(s)
+		a, b := entrySet(*x), entrySet(*y)
+
+		for _, e := range entryList(*x) {
+			if !b[e] {
+				removed[s] = append(removed[s], e)
+			}
+		}
+
+		for _, e := range entryList(*y) {
+			if !a[e] {
+				added[s] = append(added[s], e)
+			}
+		}
+	}
+
+	// NOTE: an entry removed from one scope and added to another is reported once as moved
+	moved := make(map[scopedEntry]string)
+	consumed := make(map[scopedEntry]bool)
+	for _, s := range DefaultScopeOrder {
+		for _, e := range added[s] {
+			for _, from := range DefaultScopeOrder {
+				k := scopedEntry{from, e}
+				if from != s && !consumed[k] && contains(removed[from], e) {
+					moved[scopedEntry{s, e}] = from
+					consumed[k] = true
+					break
+				}
+			}
+		}
+	}
+
+	for _, s := range DefaultScopeOrder {
+		for _, e := range removed[s] {
+			if !consumed[scopedEntry{s, e}] {
+				d.add(EntryRemoved, version, s, e, "")
+			}
+		}
+
+		for _, e := range added[s] {
+			if from, ok := moved[scopedEntry{s, e}]; ok {
+				d.add(EntryMoved, version, s, from, e)
+			} else {
+				d.add(EntryAdded, version, s, "", e)
+			}
+		}
+	}
+}
+
+// scopedEntry is an entry of a scope.
+type scopedEntry struct {
+	scope string
+	entry string
+}
+
+// entryList returns entries of a scope without duplicates.
+func entryList(entries *[]string) []string {
+	if entries == nil {
+		return nil
+	}
+
+	var o []string
+	seen := make(map[string]bool)
+	for _, e := range *entries {
+		if !seen[e] {
+			seen[e] = true
+			o = append(o, e)
+		}
+	}
+
+	return o
+}
+
+func contains(entries []string, entry string) bool {
+	for _, e := range entries {
+		if e == entry {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,166 @@
+package changelog_test
+
+import (
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Old      *string
+		New      *string
+		Expected changelog.Changeset
+	}
+
+	suite := map[string]test{
+		"Identical": {
+			Old: stringP("# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n\n## [1.0.0] - 2021-05-19\n"),
+			New: stringP("# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n\n## [1.0.0] - 2021-05-19\n"),
+		},
+		"Unreleased Entries": {
+			Old: stringP("# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n- B\n\n### Fixed\n\n- C\n"),
+			New: stringP("# Changelog\n\n## [Unreleased]\n\n### Added\n\n- B\n- A\n\n### Fixed\n\n- D\n- E\n"),
+			Expected: changelog.Changeset{
+				{Kind: changelog.EntryRemoved, Scope: "Fixed", Old: "C"},
+				{Kind: changelog.EntryAdded, Scope: "Fixed", New: "D"},
+				{Kind: changelog.EntryAdded, Scope: "Fixed", New: "E"},
+			},
+		},
+		"Moved Entries": {
+			Old: stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n- B\n\n### Fixed\n\n- B\n"),
+			New: stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Changed\n\n- A\n- B\n"),
+			Expected: changelog.Changeset{
+				{Kind: changelog.EntryMoved, Version: "1.0.0", Scope: "Changed", Old: "Added", New: "A"},
+				{Kind: changelog.EntryMoved, Version: "1.0.0", Scope: "Changed", Old: "Added", New: "B"},
+				{Kind: changelog.EntryRemoved, Version: "1.0.0", Scope: "Fixed", Old: "B"},
+			},
+		},
+		"Released": {
+			Old: stringP("# Changelog\n\n## [Unreleased]\n\n### Fixed\n\n- A\n\n## [0.1.0] - 2021-05-18\n"),
+			New: stringP("# Changelog\n\n## [Unreleased]\n\n## [1.0.0] - 2021-05-19\n\n### Fixed\n\n- A\n"),
+			Expected: changelog.Changeset{
+				{Kind: changelog.EntryRemoved, Scope: "Fixed", Old: "A"},
+				{Kind: changelog.ReleaseAdded, Version: "1.0.0", New: "## [1.0.0] - 2021-05-19\n\n### Fixed\n\n- A"},
+				{Kind: changelog.ReleaseRemoved, Version: "0.1.0", Old: "## [0.1.0] - 2021-05-18"},
+			},
+		},
+		"Release Fields": {
+			Old: stringP("# Changelog\n\nDescription\n\n## [Unreleased]\n\n## [1.1.0] - 2021-05-20 [YANKED]\n\n> Yanked: broken\n\n## [1.0.0] - 2021-05-19\n\nNotice\n\n[Unreleased]: https://github.com/o/r/compare/v1.0.0...HEAD"),
+			New: stringP("# History\n\n## [Unreleased]\n\n## [1.1.0] - 2021-05-20\n\n## [1.0.0] - 2021-05-18 [YANKED]\n\n> Yanked: security issue\n\nWarning\n\n[Unreleased]: https://github.com/o/r/compare/v1.1.0...HEAD"),
+			Expected: changelog.Changeset{
+				{Kind: changelog.TitleChanged, Old: "Changelog", New: "History"},
+				{Kind: changelog.DescriptionChanged, Old: "Description"},
+				{Kind: changelog.URLChanged, Old: "https://github.com/o/r/compare/v1.0.0...HEAD", New: "https://github.com/o/r/compare/v1.1.0...HEAD"},
+				{Kind: changelog.ReleaseUnyanked, Version: "1.1.0", Old: "broken"},
+				{Kind: changelog.DateChanged, Version: "1.0.0", Old: "2021-05-19", New: "2021-05-18"},
+				{Kind: changelog.ReleaseYanked, Version: "1.0.0", New: "security issue"},
+				{Kind: changelog.NoticeChanged, Version: "1.0.0", Old: "Notice", New: "Warning"},
+			},
+		},
+		"Yank Reason": {
+			Old: stringP("# Changelog\n\n## [1.0.0] - 2021-05-19 [YANKED]\n"),
+			New: stringP("# Changelog\n\n## [1.0.0] - 2021-05-19 [YANKED]\n\n> Yanked: broken\n"),
+			Expected: changelog.Changeset{
+				{Kind: changelog.YankReasonChanged, Version: "1.0.0", New: "broken"},
+			},
+		},
+		"Missing Changelog": {
+			New: stringP("# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n"),
+			Expected: changelog.Changeset{
+				{Kind: changelog.TitleChanged, New: "Changelog"},
+				{Kind: changelog.EntryAdded, Scope: "Added", New: "A"},
+			},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		var old, new *changelog.Changelog
+		if test.Old != nil {
+			old = parseChangelog(t, *test.Old)
+		}
+		if test.New != nil {
+			new = parseChangelog(t, *test.New)
+		}
+
+		a.Equal(test.Expected, changelog.Diff(old, new))
+	}
+}
+
+func TestChangesetFilters(t *testing.T) {
+	a := assert.New(t)
+
+	c := changelog.Changeset{
+		{Kind: changelog.TitleChanged, Old: "Changelog", New: "History"},
+		{Kind: changelog.EntryAdded, Scope: "Fixed", New: "A"},
+		{Kind: changelog.EntryAdded, Scope: "Fixed", New: "B"},
+		{Kind: changelog.EntryAdded, Scope: "Added", New: "C"},
+		{Kind: changelog.DateChanged, Version: "1.0.0", Old: "2021-05-19", New: "2021-05-18"},
+	}
+
+	t.Log("Test Case 1/3 - Unreleased")
+	a.Equal(c[1:4], c.Unreleased())
+
+	t.Log("Test Case 2/3 - Released")
+	a.Equal(c[4:], c.Released())
+
+	t.Log("Test Case 3/3 - Filter")
+	a.Equal(c[1:3], c.Unreleased().Filter(func(d changelog.Difference) bool {
+		return d.Kind == changelog.EntryAdded && d.Scope == "Fixed"
+	}))
+}
+
+func TestDifferenceString(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Difference changelog.Difference
+		Expected   string
+	}
+
+	suite := map[string]test{
+		"Title": {
+			Difference: changelog.Difference{Kind: changelog.TitleChanged, Old: "Changelog", New: "History"},
+			Expected:   `title changed: "Changelog" -> "History"`,
+		},
+		"Release Added": {
+			Difference: changelog.Difference{Kind: changelog.ReleaseAdded, Version: "1.0.0", New: "## [1.0.0] - 2021-05-19"},
+			Expected:   "release added: 1.0.0",
+		},
+		"Release Yanked": {
+			Difference: changelog.Difference{Kind: changelog.ReleaseYanked, Version: "1.0.0", New: "broken"},
+			Expected:   `release 1.0.0 yanked: "broken"`,
+		},
+		"Entry Added": {
+			Difference: changelog.Difference{Kind: changelog.EntryAdded, Scope: "Fixed", New: "A"},
+			Expected:   `entry added to Fixed in Unreleased: "A"`,
+		},
+		"Entry Removed": {
+			Difference: changelog.Difference{Kind: changelog.EntryRemoved, Version: "1.0.0", Scope: "Fixed", Old: "A"},
+			Expected:   `entry removed from Fixed in release 1.0.0: "A"`,
+		},
+		"Entry Moved": {
+			Difference: changelog.Difference{Kind: changelog.EntryMoved, Scope: "Changed", Old: "Added", New: "A"},
+			Expected:   `entry moved from Added to Changed in Unreleased: "A"`,
+		},
+		"Date Changed": {
+			Difference: changelog.Difference{Kind: changelog.DateChanged, Version: "1.0.0", Old: "2021-05-19", New: "2021-05-18"},
+			Expected:   `date changed in release 1.0.0: "2021-05-19" -> "2021-05-18"`,
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		a.Equal(test.Expected, test.Difference.String())
+	}
+}
@@ -0,0 +1,114 @@
+package changelog
+
+import (
+	"regexp"
+	"strings"
+)
+
+// entryIndentation aligns continuation lines of a multi-line entry with its text.
+const entryIndentation string = "  "
+
+var linkDefinitionRegex = regexp.MustCompile(`^\[[^\]]*\]:`)
+
+var orderedListMarkerRegex = regexp.MustCompile(`^\d{1,9}[.)]$`)
+
+// NormalizeEntry returns an entry in a form that is preserved by rendering and parsing.
+//
+// Line breaks are converted to "\n", carriage returns at the end of lines are removed
+// and surrounding whitespace is trimmed.
+// Changes.AddChange normalizes entries, so any entry it accepts is parsed back exactly
+// as it was added after ToString, unless entries are wrapped with FormatOptions.MaxWidth.
+func NormalizeEntry(entry string) string {
+	lines := strings.Split(entry, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, "\r")
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// formatEntry escapes lines of an entry that would be interpreted as Markdown headings or link definitions,
+// and indents continuation lines so they are not parsed as separate entries or sections.
+// Lines of fenced code blocks are not escaped.
+func formatEntry(entry string) string {
+	lines := strings.Split(entry, "\n")
+	code := false
+
+	for i, l := range lines {
+		if isCodeFence(l) {
+			code = !code
+		} else if !code && needsEscaping(l) {
+			l = `\` + l
+		}
+
+		if i > 0 && l != "" {
+			l = entryIndentation + l
+		}
+
+		lines[i] = l
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// parseEntry reverses formatEntry, removing indentation only when all continuation lines are indented.
+func parseEntry(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+
+	indented := true
+	for _, l := range lines[1:] {
+		if l != "" && !strings.HasPrefix(l, entryIndentation) {
+			indented = false
+			break
+		}
+	}
+
+	o := make([]string, len(lines))
+	code := false
+
+	for i, l := range lines {
+		if i > 0 && indented {
+			l = strings.TrimPrefix(l, entryIndentation)
+		}
+
+		if isCodeFence(l) {
+			code = !code
+		} else if !code && strings.HasPrefix(l, `\`) && needsEscaping(l[1:]) {
+			l = l[1:]
+		}
+
+		o[i] = l
+	}
+
+	return strings.Join(o, "\n")
+}
+
+// needsEscaping reports whether a line, ignoring leading backslashes, starts a heading or a link definition.
+func needsEscaping(line string) bool {
+	l := strings.TrimLeft(line, `\`)
+	return strings.HasPrefix(l, "#") || linkDefinitionRegex.MatchString(l)
+}
+
+// continuesParagraph reports whether a word can start a wrapped line of an entry
+// without being interpreted as a Markdown block (for example: a heading, a list item or a quote)
+// or as an escaped line that parsing would unescape.
+func continuesParagraph(word string) bool {
+	if strings.Trim(word, "-*+=_") == "" || orderedListMarkerRegex.MatchString(word) {
+		return false
+	}
+
+	for _, p := range []string{"#", ">", "<", "[", "|", `\`, "```", "~~~"} {
+		if strings.HasPrefix(word, p) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isCodeFence(line string) bool {
+	l := strings.TrimSpace(line)
+	return strings.HasPrefix(l, "```") || strings.HasPrefix(l, "~~~")
+}
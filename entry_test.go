@@ -0,0 +1,164 @@
+package changelog_test
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeEntry(t *testing.T) {
+	a := assert.New(t)
+
+	suite := map[string]struct {
+		Entry    string
+		Expected string
+	}{
+		"Plain": {
+			Entry:    "entry",
+			Expected: "entry",
+		},
+		"Surrounding Whitespace": {
+			Entry:    "\n\t  entry \n \n",
+			Expected: "entry",
+		},
+		"Carriage Returns": {
+			Entry:    "first\r\nsecond\r\r\nthird\rline\r",
+			Expected: "first\nsecond\nthird\rline",
+		},
+		"Inner Empty Lines": {
+			Entry:    "first\n\n  second",
+			Expected: "first\n\n  second",
+		},
+		"Whitespace": {
+			Entry:    " \r\n\t",
+			Expected: "",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		a.Equal(test.Expected, changelog.NormalizeEntry(test.Entry))
+	}
+}
+
+// entryFragments are building blocks of generated entries, prone to break a Markdown structure.
+var entryFragments = []string{
+	"a", "entry", " ", "  ", "\t", "\n", "\n\n", "\r\n", "\r", "\\", "#", "# ", "## [1.0.0] - 2021-05-19",
+	"## [Unreleased]", "### Added", "[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0", "[x]: ",
+	"- ", "* ", "+ ", "1. ", "> ", "> Yanked: reason", "```", "~~~", "`", "[", "]", ":", "ü", "\u00a0",
+}
+
+func entryGenerator(values []reflect.Value, r *rand.Rand) {
+	for i := range values {
+		var b strings.Builder
+		for n := r.Intn(12); n >= 0; n-- {
+			if r.Intn(5) == 0 {
+				v, _ := quick.Value(reflect.TypeOf(""), r)
+				b.WriteString(v.String())
+			} else {
+				b.WriteString(entryFragments[r.Intn(len(entryFragments))])
+			}
+		}
+
+		values[i] = reflect.ValueOf(b.String())
+	}
+}
+
+func roundTrip(t *testing.T, c *changelog.Changelog) *changelog.Changelog {
+	fs := afero.NewMemMapFs()
+	if err := c.SaveToFile(fs, "CHANGELOG.md"); err != nil {
+		t.Fatalf("error saving a changelog: %v", err)
+	}
+
+	p, err := changelog.NewParserWithFilesystem(fs, "CHANGELOG.md")
+	if err != nil {
+		t.Fatalf("error creating a parser: %v", err)
+	}
+
+	o, err := p.Parse()
+	if err != nil {
+		t.Fatalf("error parsing a changelog: %v", err)
+	}
+
+	return o
+}
+
+// scopeEntries returns entries of release scopes used by round trip tests.
+func scopeEntries(r *changelog.Release) [][]string {
+	var added, fixed []string
+	if r != nil && r.Changes != nil {
+		if r.Changes.Added != nil {
+			added = *r.Changes.Added
+		}
+
+		if r.Changes.Fixed != nil {
+			fixed = *r.Changes.Fixed
+		}
+	}
+
+	return [][]string{added, fixed}
+}
+
+func TestEntryRoundTrip(t *testing.T) {
+	t.Log("Test Case 1/1 - Generated Entries")
+
+	property := func(first, second, third string) bool {
+		c := changelog.NewChangelog()
+		r, err := c.CreateRelease("1.0.0", "2021-05-19")
+		if err != nil {
+			t.Fatalf("error preparing test case: %v", err)
+		}
+
+		for _, e := range []struct{ scope, entry string }{{"added", first}, {"added", second}, {"fixed", third}} {
+			if err := c.AddUnreleasedChange(e.scope, e.entry); err != nil {
+				t.Fatalf("error preparing test case: %v", err)
+			}
+
+			if err := r.AddChange(e.scope, e.entry); err != nil {
+				t.Fatalf("error preparing test case: %v", err)
+			}
+		}
+
+		o := roundTrip(t, c)
+
+		var parsed *changelog.Release
+		if len(o.Releases) == 1 {
+			parsed = o.Releases[0]
+		}
+
+		for _, x := range []struct{ expected, actual *changelog.Release }{{c.Unreleased, o.Unreleased}, {r, parsed}} {
+			if !reflect.DeepEqual(scopeEntries(x.expected), scopeEntries(x.actual)) {
+				t.Logf("entries differ:\n%v", c.ToString())
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if err := quick.Check(property, &quick.Config{MaxCount: 500, Values: entryGenerator}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNormalizeEntryIdempotent(t *testing.T) {
+	t.Log("Test Case 1/1 - Generated Entries")
+
+	property := func(entry string) bool {
+		n := changelog.NormalizeEntry(entry)
+		return changelog.NormalizeEntry(n) == n
+	}
+
+	if err := quick.Check(property, &quick.Config{MaxCount: 500, Values: entryGenerator}); err != nil {
+		t.Error(err)
+	}
+}
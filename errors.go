@@ -0,0 +1,49 @@
+package changelog
+
+import (
+	"fmt"
+)
+
+// ReleaseNotFoundError is returned when a release of a version does not exist.
+type ReleaseNotFoundError struct {
+	Version string
+}
+
+func (e *ReleaseNotFoundError) Error() string {
+	return fmt.Sprintf("release %v not found", e.Version)
+}
+
+// ChangeNotFoundError is returned when a change does not exist in a scope,
+// either by its text (Change) or by its position (Index).
+type ChangeNotFoundError struct {
+	Scope  string
+	Change string
+	// Index is nil when a change is looked up by its text.
+	Index *int
+}
+
+func (e *ChangeNotFoundError) Error() string {
+	if e.Index != nil {
+		return fmt.Sprintf("change %v not found in scope %v", *e.Index, e.Scope)
+	}
+
+	return fmt.Sprintf("change %q not found in scope %v", e.Change, e.Scope)
+}
+
+// UnsupportedScopeError is returned when a scope is not one of the supported scopes.
+type UnsupportedScopeError struct {
+	Scope string
+}
+
+func (e *UnsupportedScopeError) Error() string {
+	return fmt.Sprintf("unexpected scope: %v (supported: [added,changed,deprecated,removed,fixed,security])", e.Scope)
+}
+
+// EmptyScopeError is returned when a scope without changes is cleared.
+type EmptyScopeError struct {
+	Scope string
+}
+
+func (e *EmptyScopeError) Error() string {
+	return fmt.Sprintf("scope %v is empty", e.Scope)
+}
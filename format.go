@@ -0,0 +1,181 @@
+package changelog
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FormatResult describes a difference between a changelog file and its canonical form.
+type FormatResult struct {
+	// Changed is set when a file does not match its canonical form.
+	Changed bool
+	// Diff is a unified diff from a file to its canonical form, empty when nothing changed.
+	Diff string
+}
+
+// Format parses a changelog file and overwrites it with its canonical form rendered by a Markdown renderer.
+// The file is not modified when it is already formatted.
+//
+// An error is returned for files with content that would be dropped or moved by formatting,
+// such as unsupported headings, duplicate scopes or blocks between entries.
+//
+// Default Markdown renderer is used when nil.
+func Format(filesystem Filesystem, filepath string, markdown *MarkdownRenderer) (*FormatResult, error) {
+	c, o, err := checkFormat(filesystem, filepath, markdown)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Changed {
+		if err := c.SaveToFile(filesystem, filepath); err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
+
+// CheckFormat reports whether a changelog file matches its canonical form without modifying it.
+// Files that can not be formatted without losing content are reported with an error, like in Format.
+//
+// Default Markdown renderer is used when nil.
+func CheckFormat(filesystem Filesystem, filepath string, markdown *MarkdownRenderer) (*FormatResult, error) {
+	_, o, err := checkFormat(filesystem, filepath, markdown)
+	return o, err
+}
+
+func checkFormat(filesystem Filesystem, filepath string, markdown *MarkdownRenderer) (*Changelog, *FormatResult, error) {
+	if markdown == nil {
+		markdown = new(MarkdownRenderer)
+	}
+
+	if err := markdown.Format.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	p, err := NewParserWithFilesystem(filesystem, filepath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c, err := p.ParseStrict()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	original, err := readFile(filesystem, filepath)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.Markdown = markdown
+
+	formatted := c.ToString()
+	if formatted == original {
+		return c, new(FormatResult), nil
+	}
+
+	return c, &FormatResult{
+		Changed: true,
+		Diff:    UnifiedDiff("a/"+filepath, "b/"+filepath, original, formatted),
+	}, nil
+}
+
+// checkSource returns an error for content of a parsed file that is not represented by a changelog:
+// headings of releases other than supported scopes, duplicate scopes, blocks of scopes that are not entries
+// and lines around a title or link definitions.
+// Formatting such a file would drop the content or move it into other entries.
+func (p *Parser) checkSource() error {
+	heading := regexp.MustCompile(`^#{1,6}(\s|$)`)
+	fence := regexp.MustCompile("^\\s*(```|~~~)")
+	entry := regexp.MustCompile(EntryRegex)
+	empty := regexp.MustCompile(EmptyLineRegex)
+
+	margins := make(map[int]string)
+	for _, n := range p.Margins.Lines {
+		margins[n] = "scope"
+	}
+	if p.Margins.Title != nil {
+		margins[*p.Margins.Title] = "title"
+	}
+	if p.Margins.Unreleased != nil {
+		margins[*p.Margins.Unreleased] = "release"
+	}
+	for _, n := range p.Margins.Releases {
+		margins[n] = "release"
+	}
+	for _, n := range p.Margins.Links {
+		margins[n] = "link"
+	}
+
+	var release, scope, entries, links, fenced, blank bool
+	var scopes map[string]bool
+
+	for i, l := range p.Buffer {
+		switch margins[i] {
+		case "title":
+			release, scope = false, false
+			continue
+		case "release":
+			release, scope = true, false
+			scopes = make(map[string]bool)
+			continue
+		case "link":
+			release, scope, links = false, false, true
+			continue
+		case "scope":
+			if !release {
+				return errors.New(fmt.Sprintf("unexpected scope outside of a release on line %v: %v", i+1, l))
+			}
+
+			if scopes[l] {
+				return errors.New(fmt.Sprintf("duplicate scope on line %v: %v", i+1, l))
+			}
+			scopes[l] = true
+
+			scope, entries, blank = true, false, false
+			continue
+		}
+
+		if empty.MatchString(l) {
+			blank = true
+			continue
+		}
+
+		switch {
+		case links || (p.Margins.Title != nil && i < *p.Margins.Title):
+			return errors.New(fmt.Sprintf("unrecognized line %v: %v", i+1, l))
+		case release && !fenced && heading.MatchString(l):
+			return errors.New(fmt.Sprintf("unrecognized heading on line %v: %v", i+1, l))
+		case scope && entry.MatchString(l):
+			entries = true
+		case scope && !(entries && (!blank || l[0] == ' ' || l[0] == '\t')):
+			return errors.New(fmt.Sprintf("unrecognized block on line %v: %v", i+1, l))
+		}
+
+		if fence.MatchString(l) {
+			fenced = !fenced
+		}
+		blank = false
+	}
+
+	return nil
+}
+
+func readFile(filesystem Filesystem, filepath string) (string, error) {
+	f, err := filesystem.Open(filepath)
+	if err != nil {
+		return "", errors.Wrap(err, "error opening a file")
+	}
+	defer f.Close()
+
+	b := new(strings.Builder)
+	if _, err := io.Copy(b, f); err != nil {
+		return "", errors.Wrap(err, "error reading a file")
+	}
+
+	return b.String(), nil
+}
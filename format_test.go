@@ -0,0 +1,190 @@
+package changelog_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	a := assert.New(t)
+
+	type expected struct {
+		Result  *changelog.FormatResult
+		Content string
+		Error   string
+	}
+
+	type test struct {
+		Content  *string
+		Markdown *changelog.MarkdownRenderer
+		Expected expected
+	}
+
+	suite := map[string]test{
+		"Formatted": {
+			Content: stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n"),
+			Expected: expected{
+				Result:  &changelog.FormatResult{},
+				Content: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n",
+			},
+		},
+		"Unformatted": {
+			Content: stringP("# Changelog\n## [1.0.0] - 2021-05-19\n### Added\n* A\n"),
+			Expected: expected{
+				Result: &changelog.FormatResult{
+					Changed: true,
+					Diff:    "--- a/CHANGELOG.md\n+++ b/CHANGELOG.md\n@@ -1,4 +1,7 @@\n # Changelog\n+\n ## [1.0.0] - 2021-05-19\n+\n ### Added\n-* A\n+\n+- A\n",
+				},
+				Content: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n",
+			},
+		},
+		"Custom Format": {
+			Content: stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0"),
+			Markdown: &changelog.MarkdownRenderer{
+				Format: changelog.FormatOptions{Bullet: "*", TrailingNewline: true},
+			},
+			Expected: expected{
+				Result: &changelog.FormatResult{
+					Changed: true,
+					Diff:    "--- a/CHANGELOG.md\n+++ b/CHANGELOG.md\n@@ -4,6 +4,6 @@\n \n ### Added\n \n-- A\n+* A\n \n-[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n\\ No newline at end of file\n+[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n",
+				},
+				Content: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n* A\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n",
+			},
+		},
+		"Continuation Lines": {
+			Content: stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\nB\n- C\n\n  D\n"),
+			Expected: expected{
+				Result: &changelog.FormatResult{
+					Changed: true,
+					Diff:    "--- a/CHANGELOG.md\n+++ b/CHANGELOG.md\n@@ -5,7 +5,7 @@\n ### Added\n \n - A\n-B\n+  B\n - C\n \n   D\n",
+				},
+				Content: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n  B\n- C\n\n  D\n",
+			},
+		},
+		"Unrecognized Heading": {
+			Content: stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n\n### Performance\n\n- B\n"),
+			Expected: expected{
+				Content: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n\n### Performance\n\n- B\n",
+				Error:   "unrecognized heading on line 9: ### Performance",
+			},
+		},
+		"Unrecognized Block": {
+			Content: stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n\n<!-- comment -->\n"),
+			Expected: expected{
+				Content: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n\n<!-- comment -->\n",
+				Error:   "unrecognized block on line 9: <!-- comment -->",
+			},
+		},
+		"Duplicate Scope": {
+			Content: stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n\n### Added\n\n- B\n"),
+			Expected: expected{
+				Content: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n\n### Added\n\n- B\n",
+				Error:   "duplicate scope on line 9: ### Added",
+			},
+		},
+		"Line After Links": {
+			Content: stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n[docs]: https://example.com\n"),
+			Expected: expected{
+				Content: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n[docs]: https://example.com\n",
+				Error:   "unrecognized line 6: [docs]: https://example.com",
+			},
+		},
+		"Invalid Format": {
+			Content: stringP("# Changelog\n"),
+			Markdown: &changelog.MarkdownRenderer{
+				Format: changelog.FormatOptions{Bullet: "#"},
+			},
+			Expected: expected{
+				Content: "# Changelog\n",
+				Error:   "unexpected bullet: #",
+			},
+		},
+		"Missing File": {
+			Expected: expected{
+				Error: "file CHANGELOG.md not found: open CHANGELOG.md: file does not exist",
+			},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		for _, check := range []bool{true, false} {
+			fs := afero.NewMemMapFs()
+			if test.Content != nil {
+				if err := afero.WriteFile(fs, "CHANGELOG.md", []byte(*test.Content), 0644); err != nil {
+					t.Fatalf("error preparing test case: %v", err)
+				}
+			}
+
+			var result *changelog.FormatResult
+			var err error
+			if check {
+				result, err = changelog.CheckFormat(fs, "CHANGELOG.md", test.Markdown)
+			} else {
+				result, err = changelog.Format(fs, "CHANGELOG.md", test.Markdown)
+			}
+
+			if test.Expected.Error != "" {
+				a.EqualError(err, test.Expected.Error)
+			} else {
+				a.Equal(nil, err)
+			}
+			a.Equal(test.Expected.Result, result)
+
+			if test.Content != nil {
+				content, err := afero.ReadFile(fs, "CHANGELOG.md")
+				a.Equal(nil, err)
+
+				if check {
+					a.Equal(*test.Content, string(content))
+				} else {
+					a.Equal(test.Expected.Content, string(content))
+				}
+			}
+		}
+	}
+}
+
+func TestFormatIdempotency(t *testing.T) {
+	a := assert.New(t)
+
+	content := "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n" +
+		"- Accept a path argument, when the path is - or # 5 as a shortcut the standard input is read\n" +
+		"- Count items > 3 + 1. or 2) * 4 ### in a quoted [x]: list with a \\# sign\n"
+
+	block := regexp.MustCompile(`^\s+([-*+>#<|\\]|\d+[.)](\s|$))`)
+
+	for width := 20; width <= 80; width++ {
+		markdown := &changelog.MarkdownRenderer{Format: changelog.FormatOptions{MaxWidth: width}}
+
+		fs := afero.NewMemMapFs()
+		if err := afero.WriteFile(fs, "CHANGELOG.md", []byte(content), 0644); err != nil {
+			t.Fatalf("error preparing test case: %v", err)
+		}
+
+		_, err := changelog.Format(fs, "CHANGELOG.md", markdown)
+		a.Equal(nil, err, "width %v", width)
+
+		result, err := changelog.CheckFormat(fs, "CHANGELOG.md", markdown)
+		if a.Equal(nil, err, "width %v", width) {
+			a.Equal(false, result.Changed, "width %v: %v", width, result.Diff)
+		}
+
+		formatted, err := afero.ReadFile(fs, "CHANGELOG.md")
+		if err != nil {
+			t.Fatalf("error reading a file: %v", err)
+		}
+
+		for _, l := range strings.Split(string(formatted), "\n") {
+			a.False(block.MatchString(l), "width %v: line %q starts a block", width, l)
+		}
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/anton-yurchenko/go-changelog
 
-go 1.20
+go 1.22.0
 
 require (
 	github.com/pkg/errors v0.9.1
@@ -0,0 +1,139 @@
+package changelog
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Forge is a hosting service of a repository, it defines a format of release URLs.
+type Forge int
+
+// Supported forges.
+const (
+	GitHub Forge = iota
+	GitLab
+	Bitbucket
+	Gitea
+)
+
+// DefaultTagFormat is a tag of a release, {version} is replaced by a Semantic Version.
+const DefaultTagFormat string = "v{version}"
+
+// LinkProvider generates release URLs of a repository.
+type LinkProvider struct {
+	Forge Forge
+	// Repository is a web URL of a repository (for example: https://github.com/anton-yurchenko/go-changelog).
+	Repository string
+	// TagFormat defaults to DefaultTagFormat.
+	TagFormat string
+}
+
+// NewLinkProvider returns a link provider of a repository with a forge detected by its host.
+//
+// Self-hosted instances are detected by "gitlab"/"gitea" host prefixes, otherwise set LinkProvider.Forge manually.
+func NewLinkProvider(repository string) (*LinkProvider, error) {
+	u, err := url.Parse(repository)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid repository URL %v", repository)
+	}
+
+	host := strings.ToLower(u.Hostname())
+
+	var f Forge
+	switch {
+	case host == "github.com":
+		f = GitHub
+	case host == "gitlab.com" || strings.HasPrefix(host, "gitlab."):
+		f = GitLab
+	case host == "bitbucket.org":
+		f = Bitbucket
+	case host == "codeberg.org" || strings.HasPrefix(host, "gitea."):
+		f = Gitea
+	default:
+		return nil, errors.New(fmt.Sprintf("unable to detect a forge of %v", repository))
+	}
+
+	return &LinkProvider{
+		Forge:      f,
+		Repository: repository,
+	}, nil
+}
+
+// Tag returns a tag of a release version.
+func (p *LinkProvider) Tag(version string) string {
+	format := p.TagFormat
+	if format == "" {
+		format = DefaultTagFormat
+	}
+
+	return strings.ReplaceAll(format, "{version}", version)
+}
+
+// CompareURL returns a URL of changes between two git references.
+func (p *LinkProvider) CompareURL(from, to string) string {
+	switch p.Forge {
+	case GitLab:
+		return fmt.Sprintf("%v/-/compare/%v...%v", p.repository(), from, to)
+	case Bitbucket:
+		return fmt.Sprintf("%v/branches/compare/%v%%0D%v", p.repository(), to, from)
+	default:
+		return fmt.Sprintf("%v/compare/%v...%v", p.repository(), from, to)
+	}
+}
+
+// TagURL returns a URL of a release tag.
+func (p *LinkProvider) TagURL(version string) string {
+	switch p.Forge {
+	case GitLab:
+		return fmt.Sprintf("%v/-/tags/%v", p.repository(), p.Tag(version))
+	case Bitbucket:
+		return fmt.Sprintf("%v/src/%v", p.repository(), p.Tag(version))
+	default:
+		return fmt.Sprintf("%v/releases/tag/%v", p.repository(), p.Tag(version))
+	}
+}
+
+// ReleaseURL returns a URL of changes between a previous and a current release versions.
+// Tag URL is returned for an initial release (nil previous version).
+func (p *LinkProvider) ReleaseURL(previous *string, version string) string {
+	if previous == nil {
+		return p.TagURL(version)
+	}
+
+	return p.CompareURL(p.Tag(*previous), p.Tag(version))
+}
+
+// UnreleasedURL returns a URL of changes since the latest release.
+func (p *LinkProvider) UnreleasedURL(latest string) string {
+	return p.CompareURL(p.Tag(latest), "HEAD")
+}
+
+func (p *LinkProvider) repository() string {
+	return strings.TrimSuffix(strings.TrimRight(p.Repository, "/"), ".git")
+}
+
+// UpdateURLs regenerates URLs of all releases and an Unreleased section.
+//
+// Every release is compared to a previous one by its Semantic Version,
+// Unreleased section is compared to the latest release.
+func (c *Changelog) UpdateURLs(p *LinkProvider) {
+	releases := make(Releases, len(c.Releases))
+	copy(releases, c.Releases)
+	sort.Stable(releases)
+
+	var previous *string
+	for _, r := range releases {
+		u := p.ReleaseURL(previous, *r.Version)
+		r.URL = &u
+		previous = r.Version
+	}
+
+	if c.Unreleased != nil && previous != nil {
+		u := p.UnreleasedURL(*previous)
+		c.Unreleased.URL = &u
+	}
+}
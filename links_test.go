@@ -0,0 +1,189 @@
+package changelog_test
+
+import (
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLinkProvider(t *testing.T) {
+	a := assert.New(t)
+
+	type expected struct {
+		Forge changelog.Forge
+		Error string
+	}
+
+	type test struct {
+		Repository string
+		Expected   expected
+	}
+
+	suite := map[string]test{
+		"GitHub": {
+			Repository: "https://github.com/o/r",
+			Expected:   expected{Forge: changelog.GitHub},
+		},
+		"GitLab": {
+			Repository: "https://gitlab.com/o/r",
+			Expected:   expected{Forge: changelog.GitLab},
+		},
+		"Self-Hosted GitLab": {
+			Repository: "https://gitlab.example.com/o/r",
+			Expected:   expected{Forge: changelog.GitLab},
+		},
+		"Bitbucket": {
+			Repository: "https://bitbucket.org/o/r",
+			Expected:   expected{Forge: changelog.Bitbucket},
+		},
+		"Gitea": {
+			Repository: "https://codeberg.org/o/r",
+			Expected:   expected{Forge: changelog.Gitea},
+		},
+		"Unknown": {
+			Repository: "https://git.example.com/o/r",
+			Expected:   expected{Error: "unable to detect a forge of https://git.example.com/o/r"},
+		},
+		"Invalid": {
+			Repository: "://github.com/o/r",
+			Expected:   expected{Error: "invalid repository URL ://github.com/o/r: parse \"://github.com/o/r\": missing protocol scheme"},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		p, err := changelog.NewLinkProvider(test.Repository)
+		if test.Expected.Error != "" {
+			a.EqualError(err, test.Expected.Error)
+		} else {
+			a.Equal(nil, err)
+			a.Equal(test.Expected.Forge, p.Forge)
+			a.Equal(test.Repository, p.Repository)
+		}
+	}
+}
+
+func TestLinkProviderURLs(t *testing.T) {
+	a := assert.New(t)
+
+	type expected struct {
+		Initial    string
+		Release    string
+		Unreleased string
+	}
+
+	type test struct {
+		Provider *changelog.LinkProvider
+		Expected expected
+	}
+
+	suite := map[string]test{
+		"GitHub": {
+			Provider: &changelog.LinkProvider{Forge: changelog.GitHub, Repository: "https://github.com/o/r/"},
+			Expected: expected{
+				Initial:    "https://github.com/o/r/releases/tag/v1.0.0",
+				Release:    "https://github.com/o/r/compare/v1.0.0...v1.1.0",
+				Unreleased: "https://github.com/o/r/compare/v1.1.0...HEAD",
+			},
+		},
+		"GitLab": {
+			Provider: &changelog.LinkProvider{Forge: changelog.GitLab, Repository: "https://gitlab.com/o/r.git"},
+			Expected: expected{
+				Initial:    "https://gitlab.com/o/r/-/tags/v1.0.0",
+				Release:    "https://gitlab.com/o/r/-/compare/v1.0.0...v1.1.0",
+				Unreleased: "https://gitlab.com/o/r/-/compare/v1.1.0...HEAD",
+			},
+		},
+		"Bitbucket": {
+			Provider: &changelog.LinkProvider{Forge: changelog.Bitbucket, Repository: "https://bitbucket.org/o/r"},
+			Expected: expected{
+				Initial:    "https://bitbucket.org/o/r/src/v1.0.0",
+				Release:    "https://bitbucket.org/o/r/branches/compare/v1.1.0%0Dv1.0.0",
+				Unreleased: "https://bitbucket.org/o/r/branches/compare/HEAD%0Dv1.1.0",
+			},
+		},
+		"Gitea": {
+			Provider: &changelog.LinkProvider{Forge: changelog.Gitea, Repository: "https://codeberg.org/o/r"},
+			Expected: expected{
+				Initial:    "https://codeberg.org/o/r/releases/tag/v1.0.0",
+				Release:    "https://codeberg.org/o/r/compare/v1.0.0...v1.1.0",
+				Unreleased: "https://codeberg.org/o/r/compare/v1.1.0...HEAD",
+			},
+		},
+		"Tag Format": {
+			Provider: &changelog.LinkProvider{Forge: changelog.GitHub, Repository: "https://github.com/o/r", TagFormat: "release-{version}"},
+			Expected: expected{
+				Initial:    "https://github.com/o/r/releases/tag/release-1.0.0",
+				Release:    "https://github.com/o/r/compare/release-1.0.0...release-1.1.0",
+				Unreleased: "https://github.com/o/r/compare/release-1.1.0...HEAD",
+			},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		a.Equal(test.Expected.Initial, test.Provider.ReleaseURL(nil, "1.0.0"))
+		a.Equal(test.Expected.Release, test.Provider.ReleaseURL(stringP("1.0.0"), "1.1.0"))
+		a.Equal(test.Expected.Unreleased, test.Provider.UnreleasedURL("1.1.0"))
+	}
+}
+
+func TestUpdateURLs(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Changelog *changelog.Changelog
+		Expected  *changelog.Changelog
+	}
+
+	suite := map[string]test{
+		"Empty": {
+			Changelog: &changelog.Changelog{
+				Unreleased: &changelog.Release{},
+			},
+			Expected: &changelog.Changelog{
+				Unreleased: &changelog.Release{},
+			},
+		},
+		"Releases": {
+			Changelog: &changelog.Changelog{
+				Unreleased: &changelog.Release{
+					URL: stringP("https://github.com/o/r/compare/v1.0.0...HEAD"),
+				},
+				Releases: changelog.Releases{
+					{Version: stringP("1.1.0")},
+					{Version: stringP("2.0.0")},
+					{Version: stringP("1.0.0"), URL: stringP("https://example.com")},
+				},
+			},
+			Expected: &changelog.Changelog{
+				Unreleased: &changelog.Release{
+					URL: stringP("https://github.com/o/r/compare/v2.0.0...HEAD"),
+				},
+				Releases: changelog.Releases{
+					{Version: stringP("1.1.0"), URL: stringP("https://github.com/o/r/compare/v1.0.0...v1.1.0")},
+					{Version: stringP("2.0.0"), URL: stringP("https://github.com/o/r/compare/v1.1.0...v2.0.0")},
+					{Version: stringP("1.0.0"), URL: stringP("https://github.com/o/r/releases/tag/v1.0.0")},
+				},
+			},
+		},
+	}
+
+	p := &changelog.LinkProvider{Forge: changelog.GitHub, Repository: "https://github.com/o/r"}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		test.Changelog.UpdateURLs(p)
+		a.Equal(test.Expected, test.Changelog)
+	}
+}
@@ -0,0 +1,295 @@
+package changelog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// MarkdownRenderer renders a changelog as Markdown according to a predefined format.
+type MarkdownRenderer struct {
+	// ScopeOrder defaults to DefaultScopeOrder.
+	ScopeOrder ScopeOrder
+	// PreserveScopeOrder renders scopes of parsed changes in order of their appearance in a file.
+	PreserveScopeOrder bool
+	// Format defaults to a format matching Keep a Changelog examples.
+	Format FormatOptions
+	// LinkStyle defaults to ReferenceLinks.
+	LinkStyle LinkStyle
+	// ReleaseOrder defaults to SemVerOrder.
+	ReleaseOrder ReleaseOrder
+}
+
+// LinkStyle defines how release URLs are rendered.
+type LinkStyle int
+
+// Supported link styles.
+const (
+	// ReferenceLinks renders link definitions at the end of a changelog (for example: [1.0.0]: <url>).
+	ReferenceLinks LinkStyle = iota
+	// InlineLinks renders links in release headings (for example: ## [1.0.0](<url>) - 2021-05-19).
+	InlineLinks
+	// PreserveLinks renders every release link in a style it was parsed with.
+	PreserveLinks
+)
+
+// inlineLink returns true when a release URL should be rendered in its heading.
+func (m *MarkdownRenderer) inlineLink(r *Release) bool {
+	switch m.LinkStyle {
+	case InlineLinks:
+		return true
+	case PreserveLinks:
+		return r.InlineLink
+	default:
+		return false
+	}
+}
+
+// FormatOptions configures Markdown output, for example to satisfy markdownlint rules.
+//
+// A zero value keeps default formatting.
+type FormatOptions struct {
+	// Bullet is a list item marker: "-", "*" or "+". Defaults to "-".
+	Bullet string
+	// BlankLines is an amount of empty lines between blocks. Defaults to 1, so 0 renders like 1
+	// (use CompactHeadings to omit empty lines between headings and their content).
+	BlankLines int
+	// CompactHeadings omits empty lines between headings and their content.
+	CompactHeadings bool
+	// MaxWidth wraps entries longer than a provided amount of characters with a hanging indentation,
+	// 0 disables wrapping. Lines are not broken before words that would start a Markdown block
+	// (for example: "#", "-" or ">"), so such lines may exceed the width.
+	MaxWidth int
+	// TrailingNewline ends output with exactly one newline character.
+	TrailingNewline bool
+}
+
+// Validate checks if format options are supported.
+func (f FormatOptions) Validate() error {
+	switch f.Bullet {
+	case "", "-", "*", "+":
+	default:
+		return errors.New(fmt.Sprintf("unexpected bullet: %v", f.Bullet))
+	}
+
+	if f.BlankLines < 0 {
+		return errors.New(fmt.Sprintf("unexpected amount of blank lines: %v", f.BlankLines))
+	}
+
+	if f.MaxWidth < 0 {
+		return errors.New(fmt.Sprintf("unexpected max width: %v", f.MaxWidth))
+	}
+
+	return nil
+}
+
+func (f FormatOptions) bullet() string {
+	if f.Bullet == "" {
+		return "-"
+	}
+
+	return f.Bullet
+}
+
+// separator returns a string joining blocks ending with a newline.
+func (f FormatOptions) separator() string {
+	if f.BlankLines == 0 {
+		return "\n"
+	}
+
+	return strings.Repeat("\n", f.BlankLines)
+}
+
+// heading joins a heading with its content.
+func (f FormatOptions) heading(heading, content string) string {
+	if content == "" {
+		return heading
+	}
+
+	if f.CompactHeadings {
+		return heading + content
+	}
+
+	return heading + f.separator() + content
+}
+
+// entry formats a list item, wrapping lines longer than a max width outside of code blocks.
+func (f FormatOptions) entry(e string) string {
+	bullet := f.bullet()
+	text := fmt.Sprintf("%v %v", bullet, formatEntry(e))
+	if f.MaxWidth <= 0 {
+		return text
+	}
+
+	var o []string
+	code := false
+	hanging := utf8.RuneCountInString(bullet) + 1
+
+	for i, l := range strings.Split(text, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(l), "```") {
+			code = !code
+		}
+
+		if code || utf8.RuneCountInString(l) <= f.MaxWidth || strings.TrimSpace(l) == "" {
+			o = append(o, l)
+			continue
+		}
+
+		first := 0
+		if i > 0 {
+			first = len(l) - len(strings.TrimLeft(l, " "))
+		}
+		o = append(o, wrapLine(l, f.MaxWidth, first, hanging, continuesParagraph)...)
+	}
+
+	return strings.Join(o, "\n")
+}
+
+func (f FormatOptions) finish(s string) string {
+	if !f.TrailingNewline || s == "" {
+		return s
+	}
+
+	return strings.TrimRight(s, "\n") + "\n"
+}
+
+// RenderChangelog writes a Markdown formatted Changelog struct.
+func (m *MarkdownRenderer) RenderChangelog(w io.Writer, c *Changelog) error {
+	if err := m.Format.Validate(); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, m.changelog(c))
+	return err
+}
+
+// RenderRelease writes a Markdown formatted Release struct followed by its link definition.
+func (m *MarkdownRenderer) RenderRelease(w io.Writer, r *Release) error {
+	if err := m.Format.Validate(); err != nil {
+		return err
+	}
+
+	o, d := m.release(r)
+	if d != "" {
+		o = strings.Join([]string{o, d}, m.Format.separator())
+	}
+
+	_, err := io.WriteString(w, m.Format.finish(o))
+	return err
+}
+
+// RenderChanges writes a Markdown formatted Changes struct.
+func (m *MarkdownRenderer) RenderChanges(w io.Writer, c *Changes) error {
+	if err := m.Format.Validate(); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, m.Format.finish(m.changes(c)))
+	return err
+}
+
+func (m *MarkdownRenderer) changelog(c *Changelog) string {
+	var o []string
+	var defs []string
+
+	if c.Title != nil {
+		o = append(o, fmt.Sprintf("# %v\n", *c.Title))
+	}
+
+	if c.Description != nil {
+		o = append(o, fmt.Sprintf("%v\n", *c.Description))
+	}
+
+	if c.Unreleased != nil {
+		u, d := m.release(c.Unreleased)
+		o = append(o, u)
+		if d != "" {
+			defs = append(defs, d)
+		}
+	}
+
+	for _, release := range c.Releases.Sorted(m.ReleaseOrder) {
+		r, d := m.release(release)
+		o = append(o, r)
+		if d != "" {
+			defs = append(defs, d)
+		}
+	}
+
+	if len(defs) > 0 {
+		o = append(o, strings.Join(defs, "\n"))
+	}
+
+	return m.Format.finish(strings.Join(o, m.Format.separator()))
+}
+
+func (m *MarkdownRenderer) release(r *Release) (string, string) {
+	var o string
+	var u string
+
+	name := "Unreleased"
+	if r.Version != nil {
+		name = *r.Version
+	}
+
+	title := fmt.Sprintf("[%v]", name)
+	if r.URL != nil {
+		if m.inlineLink(r) {
+			title = fmt.Sprintf("[%v](%v)", name, *r.URL)
+		} else {
+			u = fmt.Sprintf("[%v]: %v", name, *r.URL)
+		}
+	}
+
+	if r.Version != nil && r.Date != nil {
+		title = fmt.Sprintf("%v - %v", title, formatDate(r.Date))
+	}
+
+	if r.Yanked {
+		title = fmt.Sprintf("%v [YANKED]", title)
+	}
+
+	o = fmt.Sprintf("## %v\n", title)
+
+	var content []string
+	if r.Yanked && r.YankReason != nil {
+		content = append(content, fmt.Sprintf("> Yanked: %v\n", *r.YankReason))
+	}
+
+	if r.Changes != nil {
+		content = append(content, m.changes(r.Changes))
+	}
+
+	if len(content) > 0 {
+		o = m.Format.heading(o, strings.Join(content, m.Format.separator()))
+	}
+
+	return o, u
+}
+
+func (m *MarkdownRenderer) changes(c *Changes) string {
+	var o []string
+	if c.Notice != nil {
+		o = append(o, fmt.Sprintf("%v\n", *c.Notice))
+	}
+
+	for _, s := range c.orderedScopes(m.ScopeOrder, m.PreserveScopeOrder) {
+		if s.entries != nil {
+			o = append(o, m.Format.heading(fmt.Sprintf("### %v\n", s.name), fmt.Sprintf("%v\n", m.scopeToString(s.entries))))
+		}
+	}
+
+	return strings.Join(o, m.Format.separator())
+}
+
+func (m *MarkdownRenderer) scopeToString(scope *[]string) string {
+	var o []string
+	for _, c := range *scope {
+		o = append(o, m.Format.entry(c))
+	}
+
+	return strings.Join(o, "\n")
+}
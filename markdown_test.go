@@ -0,0 +1,446 @@
+package changelog_test
+
+import (
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdownScopeOrder(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Renderer *changelog.MarkdownRenderer
+		Changes  *changelog.Changes
+		Expected string
+	}
+
+	changes := &changelog.Changes{
+		Added:      sliceOfStringsP([]string{"A"}),
+		Changed:    sliceOfStringsP([]string{"C"}),
+		Deprecated: sliceOfStringsP([]string{"D"}),
+		Removed:    sliceOfStringsP([]string{"R"}),
+		Fixed:      sliceOfStringsP([]string{"F"}),
+		Security:   sliceOfStringsP([]string{"S"}),
+	}
+
+	suite := map[string]test{
+		"Default": {
+			Renderer: new(changelog.MarkdownRenderer),
+			Changes:  changes,
+			Expected: "S C A R F D",
+		},
+		"Keep a Changelog": {
+			Renderer: &changelog.MarkdownRenderer{
+				ScopeOrder: changelog.KeepAChangelogScopeOrder,
+			},
+			Changes:  changes,
+			Expected: "A C D R F S",
+		},
+		"Common Changelog": {
+			Renderer: &changelog.MarkdownRenderer{
+				ScopeOrder: changelog.CommonChangelogScopeOrder,
+			},
+			Changes:  changes,
+			Expected: "C A R F D S",
+		},
+		"Partial Order": {
+			Renderer: &changelog.MarkdownRenderer{
+				ScopeOrder: changelog.ScopeOrder{"Fixed"},
+			},
+			Changes:  changes,
+			Expected: "F S C A R D",
+		},
+		"Preserve Without Parsed Order": {
+			Renderer: &changelog.MarkdownRenderer{
+				ScopeOrder:         changelog.KeepAChangelogScopeOrder,
+				PreserveScopeOrder: true,
+			},
+			Changes:  changes,
+			Expected: "A C D R F S",
+		},
+		"Preserve": {
+			Renderer: &changelog.MarkdownRenderer{
+				ScopeOrder:         changelog.KeepAChangelogScopeOrder,
+				PreserveScopeOrder: true,
+			},
+			Changes: &changelog.Changes{
+				Added:      sliceOfStringsP([]string{"A"}),
+				Changed:    sliceOfStringsP([]string{"C"}),
+				Deprecated: sliceOfStringsP([]string{"D"}),
+				Removed:    sliceOfStringsP([]string{"R"}),
+				Fixed:      sliceOfStringsP([]string{"F"}),
+				Security:   sliceOfStringsP([]string{"S"}),
+				Order:      changelog.ScopeOrder{"Fixed", "Added"},
+			},
+			Expected: "F A C D R S",
+		},
+		"Ignore Parsed Order": {
+			Renderer: new(changelog.MarkdownRenderer),
+			Changes: &changelog.Changes{
+				Added: sliceOfStringsP([]string{"A"}),
+				Fixed: sliceOfStringsP([]string{"F"}),
+				Order: changelog.ScopeOrder{"Fixed", "Added"},
+			},
+			Expected: "A F",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c := &changelog.Changelog{
+			Markdown: test.Renderer,
+			Releases: changelog.Releases{
+				{
+					Version: stringP("1.0.0"),
+					Changes: test.Changes,
+				},
+			},
+		}
+
+		a.Equal(test.Expected, scopeInitials(c.ToString()))
+	}
+}
+
+// scopeInitials returns initials of scopes in order of their headings.
+func scopeInitials(markdown string) string {
+	var o []byte
+	for i := 0; i+4 < len(markdown); i++ {
+		if markdown[i:i+4] == "### " {
+			if len(o) > 0 {
+				o = append(o, ' ')
+			}
+			o = append(o, markdown[i+4])
+		}
+	}
+
+	return string(o)
+}
+
+func TestMarkdownPreserveScopeOrderRoundTrip(t *testing.T) {
+	a := assert.New(t)
+	fs := afero.NewMemMapFs()
+
+	content := `# Changelog
+
+## [1.0.0] - 2021-05-19
+
+### Added
+
+- A
+
+### Fixed
+
+- F
+
+### Changed
+
+- C
+`
+
+	t.Log("Test Case 1/1 - Parse and Save")
+	if err := afero.WriteFile(fs, "CHANGELOG.md", []byte(content), 0644); err != nil {
+		t.Fatalf("error preparing test case: %v", err)
+	}
+
+	p, err := changelog.NewParserWithFilesystem(fs, "CHANGELOG.md")
+	if err != nil {
+		t.Fatalf("error preparing test case: %v", err)
+	}
+
+	c, err := p.Parse()
+	a.Equal(nil, err)
+
+	c.Markdown = &changelog.MarkdownRenderer{PreserveScopeOrder: true}
+	a.Equal(nil, c.SaveToFile(fs, "CHANGELOG.md"))
+
+	result, err := afero.ReadFile(fs, "CHANGELOG.md")
+	a.Equal(nil, err)
+	a.Equal(content, string(result))
+}
+
+func TestMarkdownFormatOptions(t *testing.T) {
+	a := assert.New(t)
+
+	c := &changelog.Changelog{
+		Title: stringP("Changelog"),
+		Releases: changelog.Releases{
+			{
+				Version: stringP("1.0.0"),
+				Date:    dateP("2021-05-19"),
+				URL:     stringP("https://github.com/o/r/releases/tag/v1.0.0"),
+				Changes: &changelog.Changes{
+					Added: sliceOfStringsP([]string{
+						"a very long entry that does not fit into a single line",
+						"short",
+					}),
+					Fixed: sliceOfStringsP([]string{
+						"F",
+					}),
+				},
+			},
+		},
+	}
+
+	type test struct {
+		Format   changelog.FormatOptions
+		Expected string
+	}
+
+	suite := map[string]test{
+		"Default": {
+			Format:   changelog.FormatOptions{},
+			Expected: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Fixed\n\n- F\n\n### Added\n\n- a very long entry that does not fit into a single line\n- short\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+		},
+		"Bullet": {
+			Format:   changelog.FormatOptions{Bullet: "*"},
+			Expected: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Fixed\n\n* F\n\n### Added\n\n* a very long entry that does not fit into a single line\n* short\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+		},
+		"Blank Lines": {
+			Format:   changelog.FormatOptions{BlankLines: 2},
+			Expected: "# Changelog\n\n\n## [1.0.0] - 2021-05-19\n\n\n### Fixed\n\n\n- F\n\n\n### Added\n\n\n- a very long entry that does not fit into a single line\n- short\n\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+		},
+		"Compact Headings": {
+			Format:   changelog.FormatOptions{CompactHeadings: true},
+			Expected: "# Changelog\n\n## [1.0.0] - 2021-05-19\n### Fixed\n- F\n\n### Added\n- a very long entry that does not fit into a single line\n- short\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+		},
+		"Max Width": {
+			Format:   changelog.FormatOptions{Bullet: "+", MaxWidth: 20},
+			Expected: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Fixed\n\n+ F\n\n### Added\n\n+ a very long entry\n  that does not fit\n  into a single line\n+ short\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+		},
+		"Trailing Newline": {
+			Format:   changelog.FormatOptions{TrailingNewline: true},
+			Expected: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Fixed\n\n- F\n\n### Added\n\n- a very long entry that does not fit into a single line\n- short\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c.Markdown = &changelog.MarkdownRenderer{
+			ScopeOrder: changelog.ScopeOrder{"fixed"},
+			Format:     test.Format,
+		}
+		a.Equal(test.Expected, c.ToString())
+	}
+}
+
+func TestFormatOptionsValidate(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Format changelog.FormatOptions
+		Error  string
+	}
+
+	suite := map[string]test{
+		"Default": {
+			Format: changelog.FormatOptions{},
+		},
+		"Valid": {
+			Format: changelog.FormatOptions{Bullet: "+", BlankLines: 2, MaxWidth: 80},
+		},
+		"Bullet": {
+			Format: changelog.FormatOptions{Bullet: "#"},
+			Error:  "unexpected bullet: #",
+		},
+		"Blank Lines": {
+			Format: changelog.FormatOptions{BlankLines: -1},
+			Error:  "unexpected amount of blank lines: -1",
+		},
+		"Max Width": {
+			Format: changelog.FormatOptions{MaxWidth: -1},
+			Error:  "unexpected max width: -1",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		err := test.Format.Validate()
+		if test.Error != "" {
+			a.EqualError(err, test.Error)
+		} else {
+			a.Equal(nil, err)
+		}
+	}
+}
+
+func TestMarkdownLinkStyle(t *testing.T) {
+	a := assert.New(t)
+
+	c := &changelog.Changelog{
+		Unreleased: &changelog.Release{
+			URL:        stringP("https://github.com/o/r/compare/v1.0.0...HEAD"),
+			InlineLink: true,
+		},
+		Releases: changelog.Releases{
+			{
+				Version: stringP("1.0.0"),
+				Date:    dateP("2021-05-19"),
+				URL:     stringP("https://github.com/o/r/releases/tag/v1.0.0"),
+				Changes: &changelog.Changes{
+					Added: sliceOfStringsP([]string{"A"}),
+				},
+			},
+			{
+				Version: stringP("0.1.0"),
+				Date:    dateP("2021-05-18"),
+			},
+		},
+	}
+
+	suite := map[changelog.LinkStyle]string{
+		changelog.ReferenceLinks: "## [Unreleased]\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n\n## [0.1.0] - 2021-05-18\n\n[Unreleased]: https://github.com/o/r/compare/v1.0.0...HEAD\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+		changelog.InlineLinks:    "## [Unreleased](https://github.com/o/r/compare/v1.0.0...HEAD)\n\n## [1.0.0](https://github.com/o/r/releases/tag/v1.0.0) - 2021-05-19\n\n### Added\n\n- A\n\n## [0.1.0] - 2021-05-18\n",
+		changelog.PreserveLinks:  "## [Unreleased](https://github.com/o/r/compare/v1.0.0...HEAD)\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n\n## [0.1.0] - 2021-05-18\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+	}
+
+	var counter int
+	for style, expected := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %v", counter, len(suite), style)
+
+		c.Markdown = &changelog.MarkdownRenderer{LinkStyle: style}
+		a.Equal(expected, c.ToString())
+	}
+}
+
+func TestMarkdownPreserveLinksRoundTrip(t *testing.T) {
+	a := assert.New(t)
+	fs := afero.NewMemMapFs()
+
+	content := `# Changelog
+
+## [Unreleased](https://github.com/o/r/compare/v1.0.0...HEAD)
+
+## [1.0.0] - 2021-05-19
+
+### Added
+
+- A
+
+## [0.1.0](https://github.com/o/r/releases/tag/v0.1.0) - 2021-05-18
+
+### Fixed
+
+- F
+
+[1.0.0]: https://github.com/o/r/compare/v0.1.0...v1.0.0`
+
+	t.Log("Test Case 1/1 - Parse and Save")
+	if err := afero.WriteFile(fs, "CHANGELOG.md", []byte(content), 0644); err != nil {
+		t.Fatalf("error preparing test case: %v", err)
+	}
+
+	p, err := changelog.NewParserWithFilesystem(fs, "CHANGELOG.md")
+	if err != nil {
+		t.Fatalf("error preparing test case: %v", err)
+	}
+
+	c, err := p.Parse()
+	a.Equal(nil, err)
+
+	c.Markdown = &changelog.MarkdownRenderer{LinkStyle: changelog.PreserveLinks}
+	a.Equal(nil, c.SaveToFile(fs, "CHANGELOG.md"))
+
+	result, err := afero.ReadFile(fs, "CHANGELOG.md")
+	a.Equal(nil, err)
+	a.Equal(content, string(result))
+}
+
+func TestMarkdownYankedRoundTrip(t *testing.T) {
+	a := assert.New(t)
+	fs := afero.NewMemMapFs()
+
+	content := `# Changelog
+
+## [1.0.1] - 2021-05-20 [YANKED]
+
+> Yanked: broken build
+
+Notice
+
+### Fixed
+
+- F
+
+## [1.0.0] - 2021-05-19 [YANKED]
+
+### Added
+
+- A
+`
+
+	t.Log("Test Case 1/1 - Parse and Save")
+	if err := afero.WriteFile(fs, "CHANGELOG.md", []byte(content), 0644); err != nil {
+		t.Fatalf("error preparing test case: %v", err)
+	}
+
+	p, err := changelog.NewParserWithFilesystem(fs, "CHANGELOG.md")
+	if err != nil {
+		t.Fatalf("error preparing test case: %v", err)
+	}
+
+	c, err := p.Parse()
+	a.Equal(nil, err)
+	a.Equal(stringP("broken build"), c.GetRelease("1.0.1").YankReason)
+	a.Equal(true, c.GetRelease("1.0.0").Yanked)
+
+	a.Equal(nil, c.SaveToFile(fs, "CHANGELOG.md"))
+
+	result, err := afero.ReadFile(fs, "CHANGELOG.md")
+	a.Equal(nil, err)
+	a.Equal(content, string(result))
+}
+
+func TestMarkdownReleaseOrder(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Order    changelog.ReleaseOrder
+		Expected string
+	}
+
+	suite := map[string]test{
+		"SemVer": {
+			Order:    changelog.SemVerOrder,
+			Expected: "## [2.0.0] - 2021-06-01\n\n## [1.9.5] - 2021-06-10\n",
+		},
+		"Date": {
+			Order:    changelog.DateOrder,
+			Expected: "## [1.9.5] - 2021-06-10\n\n## [2.0.0] - 2021-06-01\n",
+		},
+		"Source": {
+			Order:    changelog.SourceOrder,
+			Expected: "## [1.9.5] - 2021-06-10\n\n## [2.0.0] - 2021-06-01\n",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c := &changelog.Changelog{
+			Releases: changelog.Releases{
+				{Version: stringP("1.9.5"), Date: dateP("2021-06-10")},
+				{Version: stringP("2.0.0"), Date: dateP("2021-06-01")},
+			},
+			Markdown: &changelog.MarkdownRenderer{ReleaseOrder: test.Order},
+		}
+
+		a.Equal(test.Expected, c.ToString())
+		a.Equal("1.9.5", *c.Releases[0].Version, "rendering must not reorder releases")
+	}
+}
@@ -0,0 +1,485 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ConflictKind is a kind of a difference that can not be merged automatically.
+type ConflictKind int
+
+// Supported kinds of conflicts.
+const (
+	// TitleConflict is reported when both sides changed a title of a changelog.
+	TitleConflict ConflictKind = iota
+	// DescriptionConflict is reported when both sides changed a description of a changelog.
+	DescriptionConflict
+	// URLConflict is reported when both sides changed a URL of a release.
+	URLConflict
+	// DateConflict is reported when both sides changed a date of a release.
+	DateConflict
+	// YankConflict is reported when both sides changed a yanked state or a yank reason of a release.
+	YankConflict
+	// NoticeConflict is reported when both sides changed a notice of a release.
+	NoticeConflict
+	// ChangesConflict is reported when both sides added the same version with different changes.
+	ChangesConflict
+	// DeletionConflict is reported when one side deleted a release that was modified by the other side.
+	DeletionConflict
+)
+
+func (k ConflictKind) String() string {
+	switch k {
+	case TitleConflict:
+		return "title"
+	case DescriptionConflict:
+		return "description"
+	case URLConflict:
+		return "url"
+	case DateConflict:
+		return "date"
+	case YankConflict:
+		return "yank"
+	case NoticeConflict:
+		return "notice"
+	case ChangesConflict:
+		return "changes"
+	case DeletionConflict:
+		return "deletion"
+	default:
+		return fmt.Sprintf("unknown (%d)", int(k))
+	}
+}
+
+// Conflict is a difference between two changelogs that can not be merged automatically.
+type Conflict struct {
+	Kind ConflictKind
+	// Version of a conflicting release, empty for the Unreleased section, title and description.
+	Version string
+	// Ours and Theirs are rendered conflicting values (empty for a missing value or a deleted release).
+	Ours   string
+	Theirs string
+}
+
+func (c Conflict) String() string {
+	var location string
+	switch {
+	case c.Kind == TitleConflict || c.Kind == DescriptionConflict:
+	case c.Version == "":
+		location = " in Unreleased"
+	default:
+		location = fmt.Sprintf(" in release %v", c.Version)
+	}
+
+	return fmt.Sprintf("%v conflict%v: ours %q, theirs %q", c.Kind, location, c.Ours, c.Theirs)
+}
+
+// Merge merges changes of two changelogs (ours and theirs) that originate from a common ancestor (base).
+//
+// Entries of each scope are merged as a union without duplicates, except for entries of the base
+// that were removed by one of the sides (for example: released from the Unreleased section).
+// Releases are merged as a union, a release that was deleted by one side and not modified by the other is deleted.
+// Fields that were changed by both sides to different values are reported as conflicts and resolved in favour of ours.
+//
+// The base may be nil (no common ancestor), ours and theirs are not modified.
+func Merge(base, ours, theirs *Changelog) (*Changelog, []Conflict) {
+	if base == nil {
+		base = new(Changelog)
+	}
+
+	m := &mergeState{}
+	o := &Changelog{
+		Markdown: ours.Markdown,
+		Clock:    ours.Clock,
+		Location: ours.Location,
+	}
+
+	if v, ok := m.pick(base.Title, ours.Title, theirs.Title, TitleConflict, ""); ok {
+		o.Title = copyString(v)
+	}
+
+	if v, ok := m.pick(base.Description, ours.Description, theirs.Description, DescriptionConflict, ""); ok {
+		o.Description = copyString(v)
+	}
+
+	if ours.Unreleased != nil || theirs.Unreleased != nil {
+		o.Unreleased = m.release(base.Unreleased, ours.Unreleased, theirs.Unreleased, "")
+	}
+
+	versions := make([]string, 0, len(ours.Releases)+len(theirs.Releases))
+	seen := make(map[string]bool)
+	for _, r := range append(append(Releases{}, ours.Releases...), theirs.Releases...) {
+		if !seen[*r.Version] {
+			seen[*r.Version] = true
+			versions = append(versions, *r.Version)
+		}
+	}
+
+	for _, v := range versions {
+		b, x, y := base.GetRelease(v), ours.GetRelease(v), theirs.GetRelease(v)
+
+		switch {
+		case x != nil && y != nil:
+			o.Releases = append(o.Releases, m.release(b, x, y, v))
+		case b == nil && x != nil:
+			o.Releases = append(o.Releases, m.release(nil, x, x, v))
+		case b == nil:
+			o.Releases = append(o.Releases, m.release(nil, y, y, v))
+		case x != nil:
+			if !equalReleases(b, x) {
+				m.conflict(DeletionConflict, v, releaseValue(x), "")
+				o.Releases = append(o.Releases, m.release(nil, x, x, v))
+			}
+		default:
+			if !equalReleases(b, y) {
+				m.conflict(DeletionConflict, v, "", releaseValue(y))
+			}
+		}
+	}
+
+	return o, m.conflicts
+}
+
+// ConflictMarkers returns a merged changelog rendered with Git conflict markers around conflicting lines.
+//
+// Lines between "<<<<<<< ours" and "=======" are rendered from the merged changelog,
+// lines between "=======" and ">>>>>>> theirs" are rendered with values of theirs for every conflict.
+func ConflictMarkers(merged, theirs *Changelog, conflicts []Conflict) string {
+	ours := merged.ToString()
+
+	ops := diffLines(splitLines(ours), splitLines(resolveTheirs(merged, theirs, conflicts).ToString()))
+
+	b := new(strings.Builder)
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			b.WriteString(ops[i].line)
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(ops) && ops[j].kind != ' ' {
+			j++
+		}
+
+		writeMarker(b, "<<<<<<< ours")
+		writeOperations(b, ops[i:j], '-')
+		writeMarker(b, "=======")
+		writeOperations(b, ops[i:j], '+')
+		writeMarker(b, ">>>>>>> theirs")
+
+		i = j
+	}
+
+	return b.String()
+}
+
+func writeOperations(b *strings.Builder, ops []diffOperation, kind byte) {
+	for _, op := range ops {
+		if op.kind == kind {
+			b.WriteString(op.line)
+		}
+	}
+}
+
+// writeMarker writes a conflict marker on a separate line.
+func writeMarker(b *strings.Builder, marker string) {
+	if b.Len() > 0 && !strings.HasSuffix(b.String(), "\n") {
+		b.WriteString("\n")
+	}
+
+	b.WriteString(marker)
+	b.WriteString("\n")
+}
+
+// resolveTheirs returns a shallow copy of a merged changelog with conflicts resolved in favour of theirs.
+func resolveTheirs(merged, theirs *Changelog, conflicts []Conflict) *Changelog {
+	o := *merged
+	o.Releases = append(Releases(nil), merged.Releases...)
+
+	for _, c := range conflicts {
+		switch c.Kind {
+		case TitleConflict:
+			o.Title = theirs.Title
+			continue
+		case DescriptionConflict:
+			o.Description = theirs.Description
+			continue
+		case DeletionConflict:
+			if r := theirs.GetRelease(c.Version); r != nil {
+				o.Releases = append(o.Releases, r)
+			} else {
+				_ = o.DeleteRelease(c.Version)
+			}
+			continue
+		}
+
+		src := theirs.Unreleased
+		if c.Version != "" {
+			src = theirs.GetRelease(c.Version)
+		}
+		if src == nil {
+			src = new(Release)
+		}
+
+		target := &o.Unreleased
+		if c.Version != "" {
+			for i, r := range o.Releases {
+				if *r.Version == c.Version {
+					target = &o.Releases[i]
+				}
+			}
+		}
+
+		r := **target
+		switch c.Kind {
+		case URLConflict:
+			r.URL = src.URL
+		case DateConflict:
+			r.Date = src.Date
+		case YankConflict:
+			r.Yanked = src.Yanked
+			r.YankReason = src.YankReason
+		case NoticeConflict:
+			changes := new(Changes)
+			if r.Changes != nil {
+				*changes = *r.Changes
+			}
+			changes.Notice = nil
+			if src.Changes != nil {
+				changes.Notice = src.Changes.Notice
+			}
+			r.Changes = changes
+		case ChangesConflict:
+			r.Changes = src.Changes
+		}
+		*target = &r
+	}
+
+	return &o
+}
+
+// mergeState collects conflicts of a merge.
+type mergeState struct {
+	conflicts []Conflict
+}
+
+func (m *mergeState) conflict(kind ConflictKind, version, ours, theirs string) {
+	m.conflicts = append(m.conflicts, Conflict{Kind: kind, Version: version, Ours: ours, Theirs: theirs})
+}
+
+// pick returns a value changed by one of the sides (ours when both sides are identical or conflicting),
+// and whether the value is set.
+func (m *mergeState) pick(base, ours, theirs *string, kind ConflictKind, version string) (*string, bool) {
+	b, x, y := stringValue(base), stringValue(ours), stringValue(theirs)
+
+	switch {
+	case x == y || b == y:
+		return ours, ours != nil
+	case b == x:
+		return theirs, theirs != nil
+	default:
+		m.conflict(kind, version, x, y)
+		return ours, ours != nil
+	}
+}
+
+// release merges a release (or the Unreleased section for an empty version) that exists on both sides.
+func (m *mergeState) release(base, ours, theirs *Release, version string) *Release {
+	added := base == nil
+	if base == nil {
+		base = new(Release)
+	}
+
+	if ours == nil {
+		ours = new(Release)
+	}
+
+	if theirs == nil {
+		theirs = new(Release)
+	}
+
+	o := &Release{
+		Version:    copyString(ours.Version),
+		InlineLink: ours.InlineLink,
+	}
+
+	if v, ok := m.pick(base.URL, ours.URL, theirs.URL, URLConflict, version); ok {
+		o.URL = copyString(v)
+	}
+
+	if v, ok := m.pick(dateString(base.Date), dateString(ours.Date), dateString(theirs.Date), DateConflict, version); ok {
+		o.Date = parseDate(*v)
+	}
+
+	yanked := ours
+	if y := yankString(theirs); y != nil {
+		if v, _ := m.pick(yankString(base), yankString(ours), y, YankConflict, version); v == y {
+			yanked = theirs
+		}
+	} else if _, ok := m.pick(yankString(base), yankString(ours), nil, YankConflict, version); !ok {
+		yanked = theirs
+	}
+	o.Yanked = yanked.Yanked
+	o.YankReason = copyString(yanked.YankReason)
+
+	if added && version != "" && !equalChanges(ours.Changes, theirs.Changes) {
+		m.conflict(ChangesConflict, version, changesValue(ours.Changes), changesValue(theirs.Changes))
+		o.Changes = m.changes(nil, ours.Changes, ours.Changes, version)
+	} else {
+		o.Changes = m.changes(base.Changes, ours.Changes, theirs.Changes, version)
+	}
+
+	return o
+}
+
+// changes merges a notice and entries of every scope.
+func (m *mergeState) changes(base, ours, theirs *Changes, version string) *Changes {
+	if ours == nil && theirs == nil {
+		return nil
+	}
+
+	if base == nil {
+		base = new(Changes)
+	}
+
+	if ours == nil {
+		ours = new(Changes)
+	}
+
+	if theirs == nil {
+		theirs = new(Changes)
+	}
+
+	order := ours.Order
+	if order == nil {
+		order = theirs.Order
+	}
+
+	o := &Changes{
+		Order: append(ScopeOrder(nil), order...),
+	}
+
+	if v, ok := m.pick(base.Notice, ours.Notice, theirs.Notice, NoticeConflict, version); ok {
+		o.Notice = copyString(v)
+	}
+
+	for _, s := range DefaultScopeOrder {
+		b, _ := base.scopeField(s)
+		x, _ := ours.scopeField(s)
+		y, _ := theirs.scopeField(s)
+		f, _ := o.scopeField(s)
+
+		*f = mergeEntries(*b, *x, *y)
+	}
+
+	return o
+}
+
+// mergeEntries returns a union of entries of both sides without duplicates,
+// omitting entries of the base that were removed by one of the sides.
+func mergeEntries(base, ours, theirs *[]string) *[]string {
+	b, x, y := entrySet(base), entrySet(ours), entrySet(theirs)
+
+	var o []string
+	seen := make(map[string]bool)
+
+	for _, entries := range []*[]string{ours, theirs} {
+		if entries == nil {
+			continue
+		}
+
+		for _, e := range *entries {
+			if seen[e] || (b[e] && !(x[e] && y[e])) {
+				continue
+			}
+
+			seen[e] = true
+			o = append(o, e)
+		}
+	}
+
+	if len(o) == 0 {
+		return nil
+	}
+
+	return &o
+}
+
+func entrySet(entries *[]string) map[string]bool {
+	o := make(map[string]bool)
+	if entries != nil {
+		for _, e := range *entries {
+			o[e] = true
+		}
+	}
+
+	return o
+}
+
+func equalReleases(a, b *Release) bool {
+	return stringValue(a.URL) == stringValue(b.URL) &&
+		stringValue(dateString(a.Date)) == stringValue(dateString(b.Date)) &&
+		stringValue(yankString(a)) == stringValue(yankString(b)) &&
+		equalChanges(a.Changes, b.Changes)
+}
+
+func equalChanges(a, b *Changes) bool {
+	return changesValue(a) == changesValue(b)
+}
+
+// releaseValue returns a Markdown formatted release for a conflict.
+func releaseValue(r *Release) string {
+	title, _ := r.ToString()
+	return strings.TrimSuffix(title, "\n")
+}
+
+// changesValue returns Markdown formatted changes for a conflict.
+func changesValue(c *Changes) string {
+	if c == nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(c.ToString(), "\n")
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func copyString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+
+	o := *s
+	return &o
+}
+
+func dateString(d *time.Time) *string {
+	if d == nil {
+		return nil
+	}
+
+	o := formatDate(d)
+	return &o
+}
+
+// yankString returns a yanked state of a release with its reason, or nil for a release that is not yanked.
+func yankString(r *Release) *string {
+	if !r.Yanked {
+		return nil
+	}
+
+	o := "yanked"
+	if r.YankReason != nil {
+		o = fmt.Sprintf("%v: %v", o, *r.YankReason)
+	}
+
+	return &o
+}
@@ -0,0 +1,263 @@
+package changelog_test
+
+import (
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseChangelog(t *testing.T, content string) *changelog.Changelog {
+	fs := afero.NewMemMapFs()
+	if err := afero.WriteFile(fs, "CHANGELOG.md", []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := changelog.NewParserWithFilesystem(fs, "CHANGELOG.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func TestMerge(t *testing.T) {
+	a := assert.New(t)
+
+	type expected struct {
+		Changelog string
+		Conflicts []changelog.Conflict
+	}
+
+	type test struct {
+		Base     *string
+		Ours     string
+		Theirs   string
+		Expected expected
+	}
+
+	suite := map[string]test{
+		"Unreleased Entries": {
+			Base:   stringP("# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n"),
+			Ours:   "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n- B\n- D\n\n### Fixed\n\n- E\n",
+			Theirs: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n- C\n- D\n",
+			Expected: expected{
+				Changelog: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n- B\n- D\n- C\n\n### Fixed\n\n- E\n",
+			},
+		},
+		"Released Entries": {
+			Base:   stringP("# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n"),
+			Ours:   "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n- B\n",
+			Theirs: "# Changelog\n\n## [Unreleased]\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n",
+			Expected: expected{
+				Changelog: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- B\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n",
+			},
+		},
+		"Removed Entries": {
+			Base:   stringP("# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n- B\n"),
+			Ours:   "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- B\n",
+			Theirs: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n",
+			Expected: expected{
+				Changelog: "# Changelog\n\n## [Unreleased]\n",
+			},
+		},
+		"Releases": {
+			Base:   stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n"),
+			Ours:   "# Changelog\n\n## [1.1.0] - 2021-05-20\n\n### Added\n\n- B\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n",
+			Theirs: "# Changelog\n\n## [1.0.0] - 2021-05-19 [YANKED]\n\n> Yanked: broken build\n\n### Added\n\n- A\n\n### Fixed\n\n- C\n",
+			Expected: expected{
+				Changelog: "# Changelog\n\n## [1.1.0] - 2021-05-20\n\n### Added\n\n- B\n\n## [1.0.0] - 2021-05-19 [YANKED]\n\n> Yanked: broken build\n\n### Added\n\n- A\n\n### Fixed\n\n- C\n",
+			},
+		},
+		"Identical Releases": {
+			Ours:   "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+			Theirs: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+			Expected: expected{
+				Changelog: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+			},
+		},
+		"Deleted Release": {
+			Base:   stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n## [0.1.0] - 2021-05-18\n"),
+			Ours:   "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n## [0.1.0] - 2021-05-18\n",
+			Theirs: "# Changelog\n\n## [1.0.0] - 2021-05-19\n",
+			Expected: expected{
+				Changelog: "# Changelog\n\n## [1.0.0] - 2021-05-19\n",
+			},
+		},
+		"Deleted Modified Release": {
+			Base:   stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n## [0.1.0] - 2021-05-18\n"),
+			Ours:   "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n## [0.1.0] - 2021-05-17\n",
+			Theirs: "# Changelog\n\n## [1.0.0] - 2021-05-19\n",
+			Expected: expected{
+				Changelog: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n## [0.1.0] - 2021-05-17\n",
+				Conflicts: []changelog.Conflict{
+					{Kind: changelog.DeletionConflict, Version: "0.1.0", Ours: "## [0.1.0] - 2021-05-17"},
+				},
+			},
+		},
+		"Conflicting Releases": {
+			Base:   stringP("# Changelog\n"),
+			Ours:   "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n",
+			Theirs: "# Changelog\n\n## [1.0.0] - 2021-05-20\n\n### Added\n\n- B\n",
+			Expected: expected{
+				Changelog: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n",
+				Conflicts: []changelog.Conflict{
+					{Kind: changelog.DateConflict, Version: "1.0.0", Ours: "2021-05-19", Theirs: "2021-05-20"},
+					{Kind: changelog.ChangesConflict, Version: "1.0.0", Ours: "### Added\n\n- A", Theirs: "### Added\n\n- B"},
+				},
+			},
+		},
+		"Conflicting Fields": {
+			Base:   stringP("# Changelog\n\nDescription\n\n## [1.0.0] - 2021-05-19\n"),
+			Ours:   "# Changes\n\nOur description\n\n## [1.0.0] - 2021-05-19\n",
+			Theirs: "# Changelog\n\nTheir description\n\n## [1.0.0] - 2021-05-19 [YANKED]\n",
+			Expected: expected{
+				Changelog: "# Changes\n\nOur description\n\n## [1.0.0] - 2021-05-19 [YANKED]\n",
+				Conflicts: []changelog.Conflict{
+					{Kind: changelog.DescriptionConflict, Ours: "Our description", Theirs: "Their description"},
+				},
+			},
+		},
+		"Conflicting Notices": {
+			Base:   stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\nNotice\n"),
+			Ours:   "# Changelog\n\n## [1.0.0] - 2021-05-19\n\nOur notice\n",
+			Theirs: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\nTheir notice\n",
+			Expected: expected{
+				Changelog: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\nOur notice\n",
+				Conflicts: []changelog.Conflict{
+					{Kind: changelog.NoticeConflict, Version: "1.0.0", Ours: "Our notice", Theirs: "Their notice"},
+				},
+			},
+		},
+		"Without Base": {
+			Ours:   "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n",
+			Theirs: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- B\n\n## [0.1.0] - 2021-05-18\n",
+			Expected: expected{
+				Changelog: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n- B\n\n## [0.1.0] - 2021-05-18\n",
+			},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		var base *changelog.Changelog
+		if test.Base != nil {
+			base = parseChangelog(t, *test.Base)
+		}
+		ours := parseChangelog(t, test.Ours)
+		theirs := parseChangelog(t, test.Theirs)
+
+		c, conflicts := changelog.Merge(base, ours, theirs)
+		a.Equal(test.Expected.Changelog, c.ToString())
+		a.Equal(test.Expected.Conflicts, conflicts)
+
+		a.Equal(test.Ours, ours.ToString())
+		a.Equal(test.Theirs, theirs.ToString())
+	}
+}
+
+func TestConflictString(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Conflict changelog.Conflict
+		Expected string
+	}
+
+	suite := map[string]test{
+		"Title": {
+			Conflict: changelog.Conflict{Kind: changelog.TitleConflict, Ours: "A", Theirs: "B"},
+			Expected: `title conflict: ours "A", theirs "B"`,
+		},
+		"Unreleased": {
+			Conflict: changelog.Conflict{Kind: changelog.URLConflict, Ours: "A", Theirs: "B"},
+			Expected: `url conflict in Unreleased: ours "A", theirs "B"`,
+		},
+		"Release": {
+			Conflict: changelog.Conflict{Kind: changelog.DeletionConflict, Version: "1.0.0", Theirs: "B"},
+			Expected: `deletion conflict in release 1.0.0: ours "", theirs "B"`,
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		a.Equal(test.Expected, test.Conflict.String())
+	}
+}
+
+func TestConflictMarkers(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Base     *string
+		Ours     string
+		Theirs   string
+		Expected string
+	}
+
+	suite := map[string]test{
+		"No Conflicts": {
+			Base:     stringP("# Changelog\n\n## [Unreleased]\n"),
+			Ours:     "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n",
+			Theirs:   "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- B\n",
+			Expected: "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n- B\n",
+		},
+		"Conflicting Release": {
+			Base:     stringP("# Changelog\n\n## [Unreleased]\n\n### Added\n\n- A\n"),
+			Ours:     "# Changelog\n\n## [Unreleased]\n\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- A\n- B\n",
+			Theirs:   "# Changelog\n\n## [Unreleased]\n\n## [1.0.0] - 2021-05-20\n\n### Added\n\n- A\n",
+			Expected: "# Changelog\n\n## [Unreleased]\n\n<<<<<<< ours\n## [1.0.0] - 2021-05-19\n=======\n## [1.0.0] - 2021-05-20\n>>>>>>> theirs\n\n### Added\n\n- A\n<<<<<<< ours\n- B\n=======\n>>>>>>> theirs\n",
+		},
+		"Conflicting Title": {
+			Base:     stringP("# Changelog\n"),
+			Ours:     "# Changes\n",
+			Theirs:   "# History\n",
+			Expected: "<<<<<<< ours\n# Changes\n=======\n# History\n>>>>>>> theirs\n",
+		},
+		"Conflicting Notice": {
+			Base:     stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\nA\n\n### Added\n\n- A\n"),
+			Ours:     "# Changelog\n\n## [1.0.0] - 2021-05-19\n\nB\n\n### Added\n\n- A\n",
+			Theirs:   "# Changelog\n\n## [1.0.0] - 2021-05-19\n\nC\n\n### Added\n\n- A\n- D\n",
+			Expected: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n<<<<<<< ours\nB\n=======\nC\n>>>>>>> theirs\n\n### Added\n\n- A\n- D\n",
+		},
+		"Deleted Release": {
+			Base:     stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n\n## [0.1.0] - 2021-05-18\n"),
+			Ours:     "# Changelog\n\n## [1.0.0] - 2021-05-19\n",
+			Theirs:   "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n## [0.1.0] - 2021-05-17\n",
+			Expected: "# Changelog\n\n## [1.0.0] - 2021-05-19\n<<<<<<< ours\n=======\n\n## [0.1.0] - 2021-05-17\n>>>>>>> theirs\n",
+		},
+		"Conflicting URL": {
+			Base:     stringP("# Changelog\n\n## [1.0.0] - 2021-05-19\n"),
+			Ours:     "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+			Theirs:   "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n[1.0.0]: https://gitlab.com/o/r/-/tags/v1.0.0",
+			Expected: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n<<<<<<< ours\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n=======\n[1.0.0]: https://gitlab.com/o/r/-/tags/v1.0.0\n>>>>>>> theirs\n",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		var base *changelog.Changelog
+		if test.Base != nil {
+			base = parseChangelog(t, *test.Base)
+		}
+		theirs := parseChangelog(t, test.Theirs)
+
+		c, conflicts := changelog.Merge(base, parseChangelog(t, test.Ours), theirs)
+		a.Equal(test.Expected, changelog.ConflictMarkers(c, theirs, conflicts))
+	}
+}
@@ -70,12 +70,38 @@ func (p *Parser) Parse() (*Changelog, error) {
 	return o, nil
 }
 
+// ParseStrict parses a changelog file like Parse and returns an error for content
+// that is not represented by a Changelog struct (for example: unsupported headings, duplicate scopes
+// or blocks between entries), as saving such a changelog would drop the content or move it into other entries.
+func (p *Parser) ParseStrict() (*Changelog, error) {
+	o, err := p.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkSource(); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
 func (p *Parser) loadBuffer() error {
+	lines, err := readLines(p.Filesystem, p.Filepath)
+	if err != nil {
+		return err
+	}
+
+	p.Buffer = lines
+	return nil
+}
+
+func readLines(filesystem Filesystem, filepath string) ([]string, error) {
 	lines := make([]string, 0)
 
-	file, err := p.Filesystem.Open(p.Filepath)
+	file, err := filesystem.Open(filepath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer file.Close()
 
@@ -85,8 +111,11 @@ func (p *Parser) loadBuffer() error {
 		lines = append(lines, scanner.Text())
 	}
 
-	p.Buffer = lines
-	return nil
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
 }
 
 func (p *Parser) identifyMargins() {
@@ -282,6 +311,7 @@ func (p *Parser) parseRelease(version *string, startingLine int, titleWithLinkRe
 		}
 
 		release.URL = &x
+		release.InlineLink = true
 	} else {
 		release.URL = p.parseLinkURL(version)
 	}
@@ -314,9 +344,40 @@ func (p *Parser) parseRelease(version *string, startingLine int, titleWithLinkRe
 		release.Changes = p.parseChanges(startingLine+1, n)
 	}
 
+	if release.Yanked && release.Changes != nil {
+		release.YankReason = parseYankReason(release.Changes)
+		if release.Changes.empty() {
+			release.Changes = nil
+		}
+	}
+
 	return release
 }
 
+// parseYankReason extracts a yank reason from the first line of a notice.
+func parseYankReason(changes *Changes) *string {
+	if changes.Notice == nil {
+		return nil
+	}
+
+	lines := strings.Split(*changes.Notice, "\n")
+	m := regexp.MustCompile(YankReasonRegex)
+	if !m.MatchString(lines[0]) {
+		return nil
+	}
+
+	reason := m.ReplaceAllString(lines[0], "${1}")
+
+	notice := strings.Join(trimLeadingAndTrailingEmptyLines(lines[1:]), "\n")
+	if notice == "" {
+		changes.Notice = nil
+	} else {
+		changes.Notice = &notice
+	}
+
+	return &reason
+}
+
 func parseDate(date string) *time.Time {
 	t, err := time.Parse(DateFormat, date)
 	if err != nil {
@@ -459,12 +520,30 @@ func (p *Parser) parseChanges(startingLine, endLine int) *Changes {
 		}
 	}
 
+	changes.Order = parseScopeOrder(changes, scopeLines)
+
 	if notEmpty {
 		return changes
 	}
 	return nil
 }
 
+// parseScopeOrder returns non empty scopes sorted by their lines.
+func parseScopeOrder(changes *Changes, scopeLines map[string]*int) ScopeOrder {
+	var o ScopeOrder
+	for _, s := range changes.scopes() {
+		if s.entries != nil {
+			o = append(o, s.name)
+		}
+	}
+
+	sort.SliceStable(o, func(i, j int) bool {
+		return *scopeLines[o[i]] < *scopeLines[o[j]]
+	})
+
+	return o
+}
+
 func getScopeLine(startingLine, endLine int, lines []int) *int {
 	var s *int
 	counter := 0
@@ -499,7 +578,8 @@ func (p *Parser) parseScopeEntries(startingLine, endLine int) *[]string {
 		start := n
 		var end int
 		if i == len(entryLines)-1 {
-			end = endLine
+			// NOTE: an ending line is inclusive
+			end = endLine + 1
 		} else {
 			end = entryLines[i+1]
 		}
@@ -509,7 +589,7 @@ func (p *Parser) parseScopeEntries(startingLine, endLine int) *[]string {
 			entry = append(entry, p.Buffer[ii])
 		}
 
-		entries = append(entries, strings.Join(trimLeadingAndTrailingEmptyLines(entry), "\n"))
+		entries = append(entries, parseEntry(trimLeadingAndTrailingEmptyLines(entry)))
 	}
 
 	return &entries
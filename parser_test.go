@@ -253,7 +253,8 @@ Line 3`,
 			Expected: expected{
 				Result: &changelog.Changelog{
 					Unreleased: &changelog.Release{
-						URL: stringP("https://github.com/anton-yurchenko/go-changelog/compare/v0.0.1...HEAD"),
+						URL:        stringP("https://github.com/anton-yurchenko/go-changelog/compare/v0.0.1...HEAD"),
+						InlineLink: true,
 					},
 					Releases: []*changelog.Release{},
 				},
@@ -273,6 +274,7 @@ Line 3`,
 								"Change 1",
 								"Change 2",
 							}),
+							Order: changelog.ScopeOrder{"Added"},
 						},
 					},
 					Releases: []*changelog.Release{},
@@ -293,6 +295,7 @@ Line 3`,
 								"Change 1",
 								"Change 2",
 							}),
+							Order: changelog.ScopeOrder{"Changed"},
 						},
 					},
 					Releases: []*changelog.Release{},
@@ -313,6 +316,7 @@ Line 3`,
 								"Change 1",
 								"Change 2",
 							}),
+							Order: changelog.ScopeOrder{"Deprecated"},
 						},
 					},
 					Releases: []*changelog.Release{},
@@ -333,6 +337,7 @@ Line 3`,
 								"Change 1",
 								"Change 2",
 							}),
+							Order: changelog.ScopeOrder{"Removed"},
 						},
 					},
 					Releases: []*changelog.Release{},
@@ -353,6 +358,7 @@ Line 3`,
 								"Change 1",
 								"Change 2",
 							}),
+							Order: changelog.ScopeOrder{"Fixed"},
 						},
 					},
 					Releases: []*changelog.Release{},
@@ -373,6 +379,7 @@ Line 3`,
 								"Change 1",
 								"Change 2",
 							}),
+							Order: changelog.ScopeOrder{"Security"},
 						},
 					},
 					Releases: []*changelog.Release{},
@@ -451,6 +458,7 @@ Notice
 								"Change 11",
 								"Change 12",
 							}),
+							Order: changelog.ScopeOrder{"Added", "Changed", "Deprecated", "Removed", "Fixed", "Security"},
 						},
 					},
 					Releases: []*changelog.Release{},
@@ -479,6 +487,7 @@ this:
 								"Change 1:\n\n```yaml\nthis:\n  that:\n  - A\n  - B\n```",
 								"Change 2",
 							}),
+							Order: changelog.ScopeOrder{"Added"},
 						},
 					},
 					Releases: []*changelog.Release{},
@@ -523,9 +532,10 @@ this:
 				Result: &changelog.Changelog{
 					Releases: []*changelog.Release{
 						{
-							Version: stringP("0.0.1"),
-							URL:     stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v0.0.1"),
-							Date:    dateP("2021-05-19"),
+							Version:    stringP("0.0.1"),
+							URL:        stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v0.0.1"),
+							InlineLink: true,
+							Date:       dateP("2021-05-19"),
 						},
 					},
 				},
@@ -591,6 +601,7 @@ Notice
 									"Change 11",
 									"Change 12",
 								}),
+								Order: changelog.ScopeOrder{"Added", "Changed", "Deprecated", "Removed", "Fixed", "Security"},
 							},
 						},
 					},
@@ -632,6 +643,7 @@ Notice`,
 									"Change 1",
 									"Change 2",
 								}),
+								Order: changelog.ScopeOrder{"Added"},
 							},
 						},
 					},
@@ -731,6 +743,7 @@ Notice
 									"Change 11",
 									"Change 12",
 								}),
+								Order: changelog.ScopeOrder{"Added", "Changed", "Deprecated", "Removed", "Fixed", "Security"},
 							},
 						},
 						{
@@ -763,6 +776,7 @@ Notice
 									"Change 11",
 									"Change 12",
 								}),
+								Order: changelog.ScopeOrder{"Added", "Changed", "Deprecated", "Removed", "Fixed", "Security"},
 							},
 						},
 					},
@@ -810,13 +824,15 @@ Notice
 				Result: &changelog.Changelog{
 					Description: stringP("Notice A\nNotice B"),
 					Unreleased: &changelog.Release{
-						URL: stringP("https://github.com/anton-yurchenko/go-changelog/compare/v0.0.2...HEAD"),
+						URL:        stringP("https://github.com/anton-yurchenko/go-changelog/compare/v0.0.2...HEAD"),
+						InlineLink: true,
 						Changes: &changelog.Changes{
 							Notice: stringP("Notice"),
 							Added: sliceOfStringsP([]string{
 								"Change 1:\nA\nB",
 								"Change 2",
 							}),
+							Order: changelog.ScopeOrder{"Added"},
 						},
 					},
 					Releases: []*changelog.Release{
@@ -834,12 +850,14 @@ Notice
 									"Change 3",
 									"Change 4",
 								}),
+								Order: changelog.ScopeOrder{"Added", "Changed"},
 							},
 						},
 						{
-							Version: stringP("0.0.1"),
-							URL:     stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v0.0.1"),
-							Date:    dateP("2021-05-19"),
+							Version:    stringP("0.0.1"),
+							URL:        stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v0.0.1"),
+							InlineLink: true,
+							Date:       dateP("2021-05-19"),
 							Changes: &changelog.Changes{
 								Notice: stringP("Notice"),
 								Added: sliceOfStringsP([]string{
@@ -850,6 +868,7 @@ Notice
 									"Change 11",
 									"Change 12",
 								}),
+								Order: changelog.ScopeOrder{"Added", "Security"},
 							},
 						},
 					},
@@ -898,13 +917,15 @@ Notice
 									"Change 3",
 									"Change 4",
 								}),
+								Order: changelog.ScopeOrder{"Added", "Changed"},
 							},
 						},
 						{
-							Version: stringP("0.0.1"),
-							URL:     stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v0.0.1"),
-							Date:    dateP("2021-05-19"),
-							Yanked:  true,
+							Version:    stringP("0.0.1"),
+							URL:        stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v0.0.1"),
+							InlineLink: true,
+							Date:       dateP("2021-05-19"),
+							Yanked:     true,
 							Changes: &changelog.Changes{
 								Notice: stringP("Notice"),
 								Added: sliceOfStringsP([]string{
@@ -915,6 +936,98 @@ Notice
 									"Change 11",
 									"Change 12",
 								}),
+								Order: changelog.ScopeOrder{"Added", "Security"},
+							},
+						},
+					},
+				},
+				Error: "",
+			},
+		},
+		"Changelog - Yank Reason": {
+			Changelog: `## [0.0.2] - 2021-05-22 [YANKED]
+
+> Yanked: broken build
+
+Notice
+
+### Added
+- Change 1
+
+## [0.0.1] - 2021-05-19 [YANKED]
+
+> Yanked: security issue
+
+## [0.0.0] - 2021-05-18
+
+> Yanked: not yanked`,
+			Expected: expected{
+				Result: &changelog.Changelog{
+					Releases: []*changelog.Release{
+						{
+							Version:    stringP("0.0.2"),
+							Date:       dateP("2021-05-22"),
+							Yanked:     true,
+							YankReason: stringP("broken build"),
+							Changes: &changelog.Changes{
+								Notice: stringP("Notice"),
+								Added: sliceOfStringsP([]string{
+									"Change 1",
+								}),
+								Order: changelog.ScopeOrder{"Added"},
+							},
+						},
+						{
+							Version:    stringP("0.0.1"),
+							Date:       dateP("2021-05-19"),
+							Yanked:     true,
+							YankReason: stringP("security issue"),
+						},
+						{
+							Version: stringP("0.0.0"),
+							Date:    dateP("2021-05-18"),
+							Changes: &changelog.Changes{
+								Notice: stringP("> Yanked: not yanked"),
+							},
+						},
+					},
+				},
+				Error: "",
+			},
+		},
+		"Changelog - Multiline Entries": {
+			Changelog: `## [1.0.0] - 2021-05-19
+### Added
+- first
+  \## [0.0.1] - 2021-05-18
+  \[x]: https://example.com
+
+  ` + "```sh" + `
+  # comment
+  ` + "```" + `
+- 
+- legacy
+unindented
+  line
+### Fixed
+- last
+  line`,
+			Expected: expected{
+				Result: &changelog.Changelog{
+					Releases: []*changelog.Release{
+						{
+							Version: stringP("1.0.0"),
+							Date:    dateP("2021-05-19"),
+							Changes: &changelog.Changes{
+								Added: sliceOfStringsP([]string{
+									"first\n## [0.0.1] - 2021-05-18\n[x]: https://example.com\n\n```sh\n# comment\n```",
+									"",
+									"legacy\nunindented\n  line",
+								}),
+								Fixed: sliceOfStringsP([]string{
+									"last\nline",
+								}),
+								Order: changelog.ScopeOrder{"Added", "Fixed"},
 							},
 						},
 					},
@@ -947,6 +1060,7 @@ Notice
 									"Change 1",
 									"Change 2",
 								}),
+								Order: changelog.ScopeOrder{"Added"},
 							},
 						},
 					},
@@ -1006,3 +1120,61 @@ Notice
 		}
 	}
 }
+
+func TestParseStrict(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Changelog string
+		Expected  *changelog.Changelog
+		Error     string
+	}
+
+	suite := map[string]test{
+		"Supported Content": {
+			Changelog: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Fixed\n\n- A\n",
+			Expected: &changelog.Changelog{
+				Title: stringP("Changelog"),
+				Releases: changelog.Releases{
+					{
+						Version: stringP("1.0.0"),
+						Date:    parseDate("2021-05-19"),
+						Changes: &changelog.Changes{
+							Fixed: sliceOfStringsP([]string{"A"}),
+							Order: changelog.ScopeOrder{"Fixed"},
+						},
+					},
+				},
+			},
+		},
+		"Unrecognized Heading": {
+			Changelog: "# Changelog\n\n## [1.0.0] - 2021-05-19\n\n### Fixed\n\n- A\n\n### Notes\n\nUpgrade carefully.\n",
+			Error:     "unrecognized heading on line 9: ### Notes",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		fs := afero.NewMemMapFs()
+		if err := afero.WriteFile(fs, "CHANGELOG.md", []byte(test.Changelog), 0644); err != nil {
+			t.Fatalf("error preparing test case: %v", err)
+		}
+
+		p, err := changelog.NewParserWithFilesystem(fs, "CHANGELOG.md")
+		if err != nil {
+			t.Fatalf("error preparing test case: error creating parser: %v", err)
+		}
+
+		c, err := p.ParseStrict()
+		if test.Error != "" {
+			a.EqualError(err, test.Error)
+			a.Nil(c)
+		} else {
+			a.Equal(nil, err)
+			a.Equal(test.Expected, c)
+		}
+	}
+}
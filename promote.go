@@ -0,0 +1,115 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PrereleaseMode defines what happens to sections of prereleases after their promotion.
+type PrereleaseMode int
+
+// Supported prerelease modes.
+const (
+	// KeepPrereleases keeps sections of prereleases unchanged.
+	KeepPrereleases PrereleaseMode = iota
+	// CollapsePrereleases keeps headings of prereleases, replacing their changes with a reference to the final release.
+	// Yanked prereleases are kept unchanged.
+	CollapsePrereleases
+	// DeletePrereleases deletes prereleases.
+	DeletePrereleases
+)
+
+// PromoteOptions configures a promotion of prereleases into a final release.
+type PromoteOptions struct {
+	Prereleases PrereleaseMode
+	// LinkPrereleases adds a notice listing prereleases that were not yanked (with their URLs) to the final release.
+	LinkPrereleases bool
+}
+
+// Promote creates a final release (for example: 2.0.0) from its latest prerelease (for example: 2.0.0-rc.2),
+// with changes of all prereleases of the final version.
+//
+// Entries of every scope and notices are listed starting from the newest prerelease,
+// duplicate entries and prereleases that were yanked are omitted. Prereleases are kept unchanged.
+func (c *Changelog) Promote(prerelease, version, date string) (*Release, error) {
+	return c.PromoteWithOptions(prerelease, version, date, PromoteOptions{})
+}
+
+// PromoteWithOptions creates a final release from its latest prerelease.
+//
+// Identical to Promote but with configurable handling of prerelease sections.
+func (c *Changelog) PromoteWithOptions(prerelease, version, date string, options PromoteOptions) (*Release, error) {
+	if c.GetRelease(prerelease) == nil {
+		return nil, &ReleaseNotFoundError{Version: prerelease}
+	}
+
+	v, err := parseSemVer(prerelease)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.prerelease == "" {
+		return nil, errors.New(fmt.Sprintf("version %v is not a prerelease", prerelease))
+	}
+
+	if v.core() != version {
+		return nil, errors.New(fmt.Sprintf("version %v is not a final version of prerelease %v (expected %v)", version, prerelease, v.core()))
+	}
+
+	prereleases := c.Releases.Prereleases().Filter(func(r *Release) bool {
+		return strings.HasPrefix(*r.Version, version+"-")
+	})
+
+	if latest := prereleases.Latest(); *latest.Version != prerelease {
+		return nil, errors.New(fmt.Sprintf("prerelease %v is not the latest prerelease of %v (found %v)", prerelease, version, *latest.Version))
+	}
+
+	changes := combineChanges(prereleases.NotYanked(), false)
+
+	if options.LinkPrereleases {
+		var links []string
+		published := prereleases.NotYanked()
+		for i := len(published) - 1; i >= 0; i-- {
+			links = append(links, prereleaseLink(published[i]))
+		}
+
+		n := fmt.Sprintf("Promoted from prereleases: %v.", strings.Join(links, ", "))
+		if changes.Notice != nil {
+			n = fmt.Sprintf("%v\n\n%v", n, *changes.Notice)
+		}
+		changes.Notice = &n
+	}
+
+	r, err := c.CreateRelease(version, date)
+	if err != nil {
+		return nil, err
+	}
+	r.Changes = changes
+
+	for _, p := range prereleases {
+		switch options.Prereleases {
+		case CollapsePrereleases:
+			if p.Yanked {
+				continue
+			}
+
+			n := fmt.Sprintf("Changes are included in %v.", version)
+			p.Changes = &Changes{Notice: &n}
+		case DeletePrereleases:
+			_ = c.DeleteRelease(*p.Version)
+		}
+	}
+
+	return r, nil
+}
+
+// prereleaseLink returns a Markdown link to a prerelease, or its version when the prerelease has no URL.
+func prereleaseLink(r *Release) string {
+	if r.URL == nil {
+		return *r.Version
+	}
+
+	return fmt.Sprintf("[%v](%v)", *r.Version, *r.URL)
+}
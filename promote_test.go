@@ -0,0 +1,135 @@
+package changelog_test
+
+import (
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/stretchr/testify/assert"
+)
+
+const promoteChangelog = `# Changelog
+
+## [2.0.0-rc.2] - 2021-05-21
+
+### Fixed
+
+- C
+
+## [2.0.0-rc.1] - 2021-05-20
+
+Configuration format was changed.
+
+### Changed
+
+- B
+
+### Fixed
+
+- C
+
+## [1.0.0] - 2021-05-18
+
+### Added
+
+- A
+
+[2.0.0-rc.2]: https://github.com/o/r/compare/v2.0.0-rc.1...v2.0.0-rc.2
+[2.0.0-rc.1]: https://github.com/o/r/compare/v1.0.0...v2.0.0-rc.1
+[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0`
+
+func TestPromote(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Changelog  *string
+		Prerelease string
+		Version    string
+		Options    *changelog.PromoteOptions
+		Expected   string
+		Error      string
+	}
+
+	suite := map[string]test{
+		"Keep": {
+			Prerelease: "2.0.0-rc.2",
+			Version:    "2.0.0",
+			Expected:   "# Changelog\n\n## [2.0.0] - 2021-05-22\n\nConfiguration format was changed.\n\n### Changed\n\n- B\n\n### Fixed\n\n- C\n\n## [2.0.0-rc.2] - 2021-05-21\n\n### Fixed\n\n- C\n\n## [2.0.0-rc.1] - 2021-05-20\n\nConfiguration format was changed.\n\n### Changed\n\n- B\n\n### Fixed\n\n- C\n\n## [1.0.0] - 2021-05-18\n\n### Added\n\n- A\n\n[2.0.0-rc.2]: https://github.com/o/r/compare/v2.0.0-rc.1...v2.0.0-rc.2\n[2.0.0-rc.1]: https://github.com/o/r/compare/v1.0.0...v2.0.0-rc.1\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+		},
+		"Collapse": {
+			Prerelease: "2.0.0-rc.2",
+			Version:    "2.0.0",
+			Options:    &changelog.PromoteOptions{Prereleases: changelog.CollapsePrereleases},
+			Expected:   "# Changelog\n\n## [2.0.0] - 2021-05-22\n\nConfiguration format was changed.\n\n### Changed\n\n- B\n\n### Fixed\n\n- C\n\n## [2.0.0-rc.2] - 2021-05-21\n\nChanges are included in 2.0.0.\n\n## [2.0.0-rc.1] - 2021-05-20\n\nChanges are included in 2.0.0.\n\n## [1.0.0] - 2021-05-18\n\n### Added\n\n- A\n\n[2.0.0-rc.2]: https://github.com/o/r/compare/v2.0.0-rc.1...v2.0.0-rc.2\n[2.0.0-rc.1]: https://github.com/o/r/compare/v1.0.0...v2.0.0-rc.1\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+		},
+		"Delete With Links": {
+			Prerelease: "2.0.0-rc.2",
+			Version:    "2.0.0",
+			Options:    &changelog.PromoteOptions{Prereleases: changelog.DeletePrereleases, LinkPrereleases: true},
+			Expected:   "# Changelog\n\n## [2.0.0] - 2021-05-22\n\nPromoted from prereleases: [2.0.0-rc.1](https://github.com/o/r/compare/v1.0.0...v2.0.0-rc.1), [2.0.0-rc.2](https://github.com/o/r/compare/v2.0.0-rc.1...v2.0.0-rc.2).\n\nConfiguration format was changed.\n\n### Changed\n\n- B\n\n### Fixed\n\n- C\n\n## [1.0.0] - 2021-05-18\n\n### Added\n\n- A\n\n[1.0.0]: https://github.com/o/r/releases/tag/v1.0.0",
+		},
+		"Yanked Prerelease": {
+			Changelog:  stringP("# Changelog\n\n## [2.0.0-rc.2] - 2021-05-21\n\n### Fixed\n\n- C\n\n## [2.0.0-rc.1] - 2021-05-20 [YANKED]\n\n### Added\n\n- B\n\n[2.0.0-rc.2]: https://github.com/o/r/compare/v2.0.0-rc.1...v2.0.0-rc.2\n[2.0.0-rc.1]: https://github.com/o/r/releases/tag/v2.0.0-rc.1"),
+			Prerelease: "2.0.0-rc.2",
+			Version:    "2.0.0",
+			Options:    &changelog.PromoteOptions{Prereleases: changelog.CollapsePrereleases, LinkPrereleases: true},
+			Expected:   "# Changelog\n\n## [2.0.0] - 2021-05-22\n\nPromoted from prereleases: [2.0.0-rc.2](https://github.com/o/r/compare/v2.0.0-rc.1...v2.0.0-rc.2).\n\n### Fixed\n\n- C\n\n## [2.0.0-rc.2] - 2021-05-21\n\nChanges are included in 2.0.0.\n\n## [2.0.0-rc.1] - 2021-05-20 [YANKED]\n\n### Added\n\n- B\n\n[2.0.0-rc.2]: https://github.com/o/r/compare/v2.0.0-rc.1...v2.0.0-rc.2\n[2.0.0-rc.1]: https://github.com/o/r/releases/tag/v2.0.0-rc.1",
+		},
+		"Not Latest Prerelease": {
+			Prerelease: "2.0.0-rc.1",
+			Version:    "2.0.0",
+			Expected:   promoteChangelog,
+			Error:      "prerelease 2.0.0-rc.1 is not the latest prerelease of 2.0.0 (found 2.0.0-rc.2)",
+		},
+		"Not Prerelease": {
+			Prerelease: "1.0.0",
+			Version:    "1.0.0",
+			Expected:   promoteChangelog,
+			Error:      "version 1.0.0 is not a prerelease",
+		},
+		"Different Version": {
+			Prerelease: "2.0.0-rc.2",
+			Version:    "2.1.0",
+			Expected:   promoteChangelog,
+			Error:      "version 2.1.0 is not a final version of prerelease 2.0.0-rc.2 (expected 2.0.0)",
+		},
+		"Missing Prerelease": {
+			Prerelease: "3.0.0-rc.1",
+			Version:    "3.0.0",
+			Expected:   promoteChangelog,
+			Error:      "release 3.0.0-rc.1 not found",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		source := promoteChangelog
+		if test.Changelog != nil {
+			source = *test.Changelog
+		}
+		c := parseChangelog(t, source)
+
+		var r *changelog.Release
+		var err error
+		if test.Options == nil {
+			r, err = c.Promote(test.Prerelease, test.Version, "2021-05-22")
+		} else {
+			r, err = c.PromoteWithOptions(test.Prerelease, test.Version, "2021-05-22", *test.Options)
+		}
+
+		if test.Error != "" {
+			a.EqualError(err, test.Error)
+			a.Nil(r)
+		} else {
+			a.Equal(nil, err)
+			a.Equal(test.Version, *r.Version)
+		}
+		a.Equal(test.Expected, c.ToString())
+
+		if test.Error == "" {
+			a.Equal(test.Expected, parseChangelog(t, test.Expected).ToString())
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/mod/semver"
+)
+
+// compareVersions compares two Semantic Versions, returning -1, 0 or 1.
+func compareVersions(a, b string) int {
+	return semver.Compare("v"+a, "v"+b)
+}
+
+// Filter returns releases matching a provided function, sorted by their Semantic Version in a descending order.
+func (r Releases) Filter(match func(*Release) bool) Releases {
+	var o Releases
+	for _, release := range r.descending() {
+		if match(release) {
+			o = append(o, release)
+		}
+	}
+
+	return o
+}
+
+// Prereleases returns releases with a prerelease version (for example: 1.0.0-rc.1).
+func (r Releases) Prereleases() Releases {
+	return r.Filter(func(release *Release) bool {
+		return semver.Prerelease("v"+*release.Version) != ""
+	})
+}
+
+// Stable returns releases without a prerelease version.
+func (r Releases) Stable() Releases {
+	return r.Filter(func(release *Release) bool {
+		return semver.Prerelease("v"+*release.Version) == ""
+	})
+}
+
+// Yanked returns yanked releases.
+func (r Releases) Yanked() Releases {
+	return r.Filter(func(release *Release) bool {
+		return release.Yanked
+	})
+}
+
+// NotYanked returns releases that are not yanked.
+func (r Releases) NotYanked() Releases {
+	return r.Filter(func(release *Release) bool {
+		return !release.Yanked
+	})
+}
+
+// Since returns releases newer than a provided version.
+//
+// Versions may be prefixed by "v" like in Range, an error is returned for an invalid version.
+func (r Releases) Since(version string) (Releases, error) {
+	v, err := queryVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Filter(func(release *Release) bool {
+		return compareVersions(*release.Version, v) > 0
+	}), nil
+}
+
+// Between returns releases newer than a "from" version, up to and including a "to" version
+// (releases an upgrade from one version to another consists of).
+//
+// Versions may be prefixed by "v" like in Range, an error is returned for an invalid version.
+func (r Releases) Between(from, to string) (Releases, error) {
+	f, err := queryVersion(from)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := queryVersion(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Filter(func(release *Release) bool {
+		return compareVersions(*release.Version, f) > 0 && compareVersions(*release.Version, t) <= 0
+	}), nil
+}
+
+// After returns releases dated after a provided date, releases without a date are omitted.
+func (r Releases) After(date time.Time) Releases {
+	return r.Filter(func(release *Release) bool {
+		return release.Date != nil && release.Date.After(date)
+	})
+}
+
+// Latest returns a release with the highest version, or nil for no releases.
+func (r Releases) Latest() *Release {
+	return r.descending().first()
+}
+
+// LatestStable returns a release with the highest version that is neither a prerelease nor yanked, or nil.
+func (r Releases) LatestStable() *Release {
+	return r.Stable().NotYanked().first()
+}
+
+// Previous returns a release with the highest version lower than a provided version, or nil.
+//
+// Versions may be prefixed by "v" like in Range, an error is returned for an invalid version.
+func (r Releases) Previous(version string) (*Release, error) {
+	v, err := queryVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Filter(func(release *Release) bool {
+		return compareVersions(*release.Version, v) < 0
+	}).first(), nil
+}
+
+// Next returns a release with the lowest version higher than a provided version, or nil.
+//
+// Versions may be prefixed by "v" like in Range, an error is returned for an invalid version.
+func (r Releases) Next(version string) (*Release, error) {
+	o, err := r.Since(version)
+	if err != nil || len(o) == 0 {
+		return nil, err
+	}
+
+	return o[len(o)-1], nil
+}
+
+// queryVersion returns a Semantic Version of a query without its "v" prefix.
+func queryVersion(version string) (string, error) {
+	v := strings.TrimPrefix(version, "v")
+	if _, err := parseSemVer(v); err != nil {
+		return "", errors.Wrap(err, "invalid version")
+	}
+
+	return v, nil
+}
+
+func (r Releases) first() *Release {
+	if len(r) == 0 {
+		return nil
+	}
+
+	return r[0]
+}
+
+// Range returns releases matching a constraint, sorted by their Semantic Version in a descending order.
+//
+// A constraint is a list of space separated comparisons that must all match (for example: ">=1.2.0 <2.0.0"),
+// alternative lists are separated by "||". Supported operators: =, !=, >, >=, <, <=,
+// a version without an operator matches exactly. Prereleases are ordered by Semantic Version precedence,
+// so "<2.0.0" matches 2.0.0-rc.1 (use Stable to omit prereleases).
+func (r Releases) Range(constraint string) (Releases, error) {
+	var alternatives [][]comparison
+
+	for _, a := range strings.Split(constraint, "||") {
+		c, err := parseComparisons(a)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid constraint %v", constraint)
+		}
+
+		alternatives = append(alternatives, c)
+	}
+
+	return r.Filter(func(release *Release) bool {
+		for _, a := range alternatives {
+			if matchComparisons(a, *release.Version) {
+				return true
+			}
+		}
+
+		return false
+	}), nil
+}
+
+// comparison is a single version comparison of a constraint.
+type comparison struct {
+	operator string
+	version  string
+}
+
+var comparisonOperators = []string{">=", "<=", "!=", ">", "<", "="}
+
+func parseComparisons(constraint string) ([]comparison, error) {
+	fields := strings.Fields(constraint)
+	if len(fields) == 0 {
+		return nil, errors.New("missing comparison")
+	}
+
+	var o []comparison
+	for i := 0; i < len(fields); i++ {
+		raw := fields[i]
+		c := comparison{operator: "=", version: fields[i]}
+		for _, op := range comparisonOperators {
+			if strings.HasPrefix(fields[i], op) {
+				c = comparison{operator: op, version: strings.TrimPrefix(fields[i], op)}
+				break
+			}
+		}
+
+		// NOTE: an operator may be separated from a version by a space (">= 1.2.0")
+		if c.version == "" && i+1 < len(fields) {
+			i++
+			c.version = fields[i]
+			raw += " " + fields[i]
+		}
+
+		v, err := queryVersion(c.version)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("unexpected comparison: %v", raw))
+		}
+		c.version = v
+
+		o = append(o, c)
+	}
+
+	return o, nil
+}
+
+func matchComparisons(comparisons []comparison, version string) bool {
+	for _, c := range comparisons {
+		r := compareVersions(version, c.version)
+
+		var ok bool
+		switch c.operator {
+		case ">=":
+			ok = r >= 0
+		case "<=":
+			ok = r <= 0
+		case "!=":
+			ok = r != 0
+		case ">":
+			ok = r > 0
+		case "<":
+			ok = r < 0
+		default:
+			ok = r == 0
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,238 @@
+package changelog_test
+
+import (
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/stretchr/testify/assert"
+)
+
+func queryReleases() changelog.Releases {
+	return changelog.Releases{
+		{Version: stringP("1.2.0"), Date: parseDate("2021-05-20")},
+		{Version: stringP("0.9.0"), Date: parseDate("2021-01-10")},
+		{Version: stringP("2.0.0-rc.1"), Date: parseDate("2021-06-01")},
+		{Version: stringP("1.10.0"), Date: parseDate("2021-05-25"), Yanked: true},
+		{Version: stringP("1.0.0"), Date: parseDate("2021-03-01")},
+		{Version: stringP("1.0.0-beta"), Date: parseDate("2021-02-01")},
+		{Version: stringP("1.9.0")},
+	}
+}
+
+func versions(r changelog.Releases) []string {
+	o := []string{}
+	for _, release := range r {
+		o = append(o, *release.Version)
+	}
+
+	return o
+}
+
+func TestReleasesRange(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Constraint string
+		Expected   []string
+		Error      string
+	}
+
+	suite := map[string]test{
+		"Bounded": {
+			Constraint: ">=1.2.0 <2.0.0",
+			Expected:   []string{"2.0.0-rc.1", "1.10.0", "1.9.0", "1.2.0"},
+		},
+		"Prerelease Precedence": {
+			Constraint: ">1.0.0-beta <=1.0.0",
+			Expected:   []string{"1.0.0"},
+		},
+		"Alternatives": {
+			Constraint: "0.9.0 || >= v2.0.0-rc.1",
+			Expected:   []string{"2.0.0-rc.1", "0.9.0"},
+		},
+		"Not Equal": {
+			Constraint: ">=1.0.0 !=1.9.0 <1.10.0",
+			Expected:   []string{"1.2.0", "1.0.0"},
+		},
+		"No Match": {
+			Constraint: ">3.0.0",
+			Expected:   []string{},
+		},
+		"Invalid Version": {
+			Constraint: ">=1.2 <2.0.0",
+			Error:      "invalid constraint >=1.2 <2.0.0: unexpected comparison: >=1.2",
+		},
+		"Missing Comparison": {
+			Constraint: ">=1.2.0 ||",
+			Error:      "invalid constraint >=1.2.0 ||: missing comparison",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		r, err := queryReleases().Range(test.Constraint)
+		if test.Error != "" {
+			a.EqualError(err, test.Error)
+			a.Nil(r)
+		} else {
+			a.Equal(nil, err)
+			a.Equal(test.Expected, versions(r))
+		}
+	}
+}
+
+func TestReleasesQueries(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Query    func(changelog.Releases) (changelog.Releases, error)
+		Expected []string
+		Error    string
+	}
+
+	suite := map[string]test{
+		"Since": {
+			Query:    func(r changelog.Releases) (changelog.Releases, error) { return r.Since("1.2.0") },
+			Expected: []string{"2.0.0-rc.1", "1.10.0", "1.9.0"},
+		},
+		"Since Missing Version": {
+			Query:    func(r changelog.Releases) (changelog.Releases, error) { return r.Since("1.5.0") },
+			Expected: []string{"2.0.0-rc.1", "1.10.0", "1.9.0"},
+		},
+		"Since Prefixed Version": {
+			Query:    func(r changelog.Releases) (changelog.Releases, error) { return r.Since("v1.2.0") },
+			Expected: []string{"2.0.0-rc.1", "1.10.0", "1.9.0"},
+		},
+		"Since Invalid Version": {
+			Query: func(r changelog.Releases) (changelog.Releases, error) { return r.Since("garbage") },
+			Error: "invalid version: invalid semantic version garbage, expected to match regex " + changelog.SemVerRegex,
+		},
+		"Between": {
+			Query:    func(r changelog.Releases) (changelog.Releases, error) { return r.Between("1.0.0", "1.10.0") },
+			Expected: []string{"1.10.0", "1.9.0", "1.2.0"},
+		},
+		"Between Prefixed Version": {
+			Query:    func(r changelog.Releases) (changelog.Releases, error) { return r.Between("1.0.0", "v1.10.0") },
+			Expected: []string{"1.10.0", "1.9.0", "1.2.0"},
+		},
+		"Between Invalid Version": {
+			Query: func(r changelog.Releases) (changelog.Releases, error) { return r.Between("1.0", "1.10.0") },
+			Error: "invalid version: invalid semantic version 1.0, expected to match regex " + changelog.SemVerRegex,
+		},
+		"After": {
+			Query: func(r changelog.Releases) (changelog.Releases, error) {
+				return r.After(*parseDate("2021-03-01")), nil
+			},
+			Expected: []string{"2.0.0-rc.1", "1.10.0", "1.2.0"},
+		},
+		"Prereleases": {
+			Query:    func(r changelog.Releases) (changelog.Releases, error) { return r.Prereleases(), nil },
+			Expected: []string{"2.0.0-rc.1", "1.0.0-beta"},
+		},
+		"Stable": {
+			Query:    func(r changelog.Releases) (changelog.Releases, error) { return r.Stable(), nil },
+			Expected: []string{"1.10.0", "1.9.0", "1.2.0", "1.0.0", "0.9.0"},
+		},
+		"Yanked": {
+			Query:    func(r changelog.Releases) (changelog.Releases, error) { return r.Yanked(), nil },
+			Expected: []string{"1.10.0"},
+		},
+		"Not Yanked": {
+			Query:    func(r changelog.Releases) (changelog.Releases, error) { return r.NotYanked().Since("1.2.0") },
+			Expected: []string{"2.0.0-rc.1", "1.9.0"},
+		},
+		"Filter": {
+			Query: func(r changelog.Releases) (changelog.Releases, error) {
+				return r.Filter(func(release *changelog.Release) bool { return release.Date == nil }), nil
+			},
+			Expected: []string{"1.9.0"},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		r, err := test.Query(queryReleases())
+		if test.Error != "" {
+			a.EqualError(err, test.Error)
+			a.Nil(r)
+		} else {
+			a.Equal(nil, err)
+			a.Equal(test.Expected, versions(r))
+		}
+	}
+}
+
+func TestReleasesNavigation(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Query    func(changelog.Releases) (*changelog.Release, error)
+		Expected *string
+		Error    string
+	}
+
+	suite := map[string]test{
+		"Latest": {
+			Query:    func(r changelog.Releases) (*changelog.Release, error) { return r.Latest(), nil },
+			Expected: stringP("2.0.0-rc.1"),
+		},
+		"Latest Stable": {
+			Query:    func(r changelog.Releases) (*changelog.Release, error) { return r.LatestStable(), nil },
+			Expected: stringP("1.9.0"),
+		},
+		"Previous": {
+			Query:    func(r changelog.Releases) (*changelog.Release, error) { return r.Previous("1.10.0") },
+			Expected: stringP("1.9.0"),
+		},
+		"Previous Prerelease": {
+			Query:    func(r changelog.Releases) (*changelog.Release, error) { return r.Previous("v1.0.0") },
+			Expected: stringP("1.0.0-beta"),
+		},
+		"Previous Missing": {
+			Query: func(r changelog.Releases) (*changelog.Release, error) { return r.Previous("0.9.0") },
+		},
+		"Previous Invalid Version": {
+			Query: func(r changelog.Releases) (*changelog.Release, error) { return r.Previous("garbage") },
+			Error: "invalid version: invalid semantic version garbage, expected to match regex " + changelog.SemVerRegex,
+		},
+		"Next": {
+			Query:    func(r changelog.Releases) (*changelog.Release, error) { return r.Next("1.2.0") },
+			Expected: stringP("1.9.0"),
+		},
+		"Next Missing": {
+			Query: func(r changelog.Releases) (*changelog.Release, error) { return r.Next("2.0.0-rc.1") },
+		},
+		"Next Invalid Version": {
+			Query: func(r changelog.Releases) (*changelog.Release, error) { return r.Next("1.2") },
+			Error: "invalid version: invalid semantic version 1.2, expected to match regex " + changelog.SemVerRegex,
+		},
+		"Empty": {
+			Query: func(changelog.Releases) (*changelog.Release, error) { return changelog.Releases{}.Latest(), nil },
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		r, err := test.Query(queryReleases())
+		if test.Error != "" {
+			a.EqualError(err, test.Error)
+		} else {
+			a.Equal(nil, err)
+		}
+
+		if test.Expected == nil {
+			a.Nil(r)
+		} else if a.NotNil(r) {
+			a.Equal(*test.Expected, *r.Version)
+		}
+	}
+}
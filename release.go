@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -15,38 +14,17 @@ type Release struct {
 	Version *string
 	Date    *time.Time
 	Yanked  bool
-	URL     *string
-	Changes *Changes
+	// YankReason is an optional explanation of a yanked release, rendered as a quote below its heading.
+	YankReason *string
+	URL        *string
+	// InlineLink is set when a URL was parsed from a release heading instead of a link definition.
+	InlineLink bool
+	Changes    *Changes
 }
 
 // ToString returns a Markdown formatted Release struct.
 func (r *Release) ToString() (string, string) {
-	var o []string
-	var u string
-
-	if r.Version != nil {
-		if r.Date != nil {
-			o = append(o, fmt.Sprintf("## [%v] - %v\n", *r.Version, formatDate(r.Date)))
-		} else {
-			o = append(o, fmt.Sprintf("## [%v]\n", *r.Version))
-		}
-	} else {
-		o = append(o, "## [Unreleased]\n")
-	}
-
-	if r.Changes != nil {
-		o = append(o, r.Changes.ToString())
-	}
-
-	if r.URL != nil {
-		if r.Version != nil {
-			u = fmt.Sprintf("[%v]: %v", *r.Version, *r.URL)
-		} else {
-			u = fmt.Sprintf("[Unreleased]: %v", *r.URL)
-		}
-	}
-
-	return strings.Join(o, "\n"), u
+	return new(MarkdownRenderer).release(r)
 }
 
 func formatDate(date *time.Time) string {
@@ -80,10 +80,10 @@ notice
 ### Added
 
 - A:
-` + "```yaml" + `
-this:
-  that:
-    - x` + "```" + `
+  ` + "```yaml" + `
+  this:
+    that:
+      - x` + "```" + `
 
 - B
 `,
@@ -3,6 +3,8 @@ package changelog
 import (
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -27,6 +29,52 @@ func (r Releases) Swap(i, j int) {
 	r[i], r[j] = r[j], r[i]
 }
 
+// ReleaseOrder defines an order of rendered releases.
+type ReleaseOrder int
+
+// Supported release orders.
+const (
+	// SemVerOrder sorts releases by their Semantic Version in a descending order.
+	SemVerOrder ReleaseOrder = iota
+	// DateOrder sorts releases by their date in a descending order,
+	// releases of the same date are sorted by their Semantic Version and releases without a date are last.
+	DateOrder
+	// SourceOrder keeps releases in order of the slice (for example: order of appearance in a parsed file).
+	SourceOrder
+)
+
+// Sorted returns a copy of releases in a provided order, releases are not modified.
+func (r Releases) Sorted(order ReleaseOrder) Releases {
+	o := make(Releases, len(r))
+	copy(o, r)
+
+	switch order {
+	case SourceOrder:
+	case DateOrder:
+		sort.SliceStable(o, func(i, j int) bool {
+			switch {
+			case o[i].Date == nil && o[j].Date == nil:
+				return o.Less(j, i)
+			case o[i].Date == nil || o[j].Date == nil:
+				return o[j].Date == nil
+			case o[i].Date.Equal(*o[j].Date):
+				return o.Less(j, i)
+			default:
+				return o[i].Date.After(*o[j].Date)
+			}
+		})
+	default:
+		sort.Stable(sort.Reverse(o))
+	}
+
+	return o
+}
+
+// descending returns a copy of releases sorted by their Semantic Version in a descending order.
+func (r Releases) descending() Releases {
+	return r.Sorted(SemVerOrder)
+}
+
 // GetRelease returns a release for a provided version.
 func (r Releases) GetRelease(version string) *Release {
 	for _, release := range r {
@@ -39,6 +87,9 @@ func (r Releases) GetRelease(version string) *Release {
 }
 
 // CreateRelease creates new empty release.
+//
+// The date must be consistent with dates of other releases of the same major and minor version,
+// like in Changelog.SetReleaseDate.
 func (r *Releases) CreateRelease(version, date string) (*Release, error) {
 	for _, e := range *r {
 		if *e.Version == version {
@@ -71,6 +122,10 @@ func (r *Releases) CreateRelease(version, date string) (*Release, error) {
 		Version: &v,
 	}
 
+	if err := r.checkChronology(release); err != nil {
+		return nil, err
+	}
+
 	*r = append(*r, release)
 
 	return release, nil
@@ -91,3 +146,84 @@ func (r *Releases) CreateReleaseWithURL(version, date, url string) (*Release, er
 
 	return release, nil
 }
+
+// checkChronology verifies that a release date is consistent with dates of other releases of the same major and minor version:
+// lower versions must not be newer and higher versions must not be older.
+// Releases of different minor versions are not compared, so patches may be backported to older minor versions.
+func (r Releases) checkChronology(release *Release) error {
+	if release.Date == nil {
+		return nil
+	}
+
+	v, err := parseSemVer(*release.Version)
+	if err != nil {
+		return err
+	}
+
+	for _, x := range r {
+		if x == release || x.Date == nil {
+			continue
+		}
+
+		o, err := parseSemVer(*x.Version)
+		if err != nil || o.major != v.major || o.minor != v.minor {
+			continue
+		}
+
+		c := semver.Compare("v"+*x.Version, "v"+*release.Version)
+		if (c < 0 && x.Date.After(*release.Date)) || (c > 0 && x.Date.Before(*release.Date)) {
+			return errors.New(fmt.Sprintf("date %v of release %v is inconsistent with date %v of release %v", formatDate(release.Date), *release.Version, formatDate(x.Date), *x.Version))
+		}
+	}
+
+	return nil
+}
+
+// replaceVersion replaces whole occurrences of a version in a text (for example: in a tag v1.0.0 of a URL).
+func replaceVersion(text, old, new string) string {
+	var b strings.Builder
+
+	for {
+		i := strings.Index(text, old)
+		if i == -1 {
+			b.WriteString(text)
+			return b.String()
+		}
+
+		end := i + len(old)
+		if versionBoundary(text[:i], text[end:]) {
+			b.WriteString(text[:i])
+			b.WriteString(new)
+		} else {
+			b.WriteString(text[:end])
+		}
+
+		text = text[end:]
+	}
+}
+
+// versionBoundary reports whether a version is surrounded by characters that are not a part of it.
+func versionBoundary(before, after string) bool {
+	if before != "" {
+		c := before[len(before)-1]
+		if c == '.' || (c >= '0' && c <= '9') {
+			return false
+		}
+	}
+
+	if after == "" {
+		return true
+	}
+
+	c := after[0]
+	switch {
+	case c == '.':
+		return strings.HasPrefix(after, "..")
+	case c == '-' || c == '+' || c == '_':
+		return false
+	case c >= '0' && c <= '9', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		return false
+	default:
+		return true
+	}
+}
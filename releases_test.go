@@ -119,6 +119,53 @@ func TestReleasesLess(t *testing.T) {
 	}
 }
 
+func TestReleasesSorted(t *testing.T) {
+	a := assert.New(t)
+
+	releases := changelog.Releases{
+		{Version: stringP("1.9.5"), Date: parseDate("2021-06-10")},
+		{Version: stringP("2.0.0"), Date: parseDate("2021-06-01")},
+		{Version: stringP("0.9.0")},
+		{Version: stringP("1.9.4"), Date: parseDate("2021-05-01")},
+		{Version: stringP("2.0.1"), Date: parseDate("2021-06-10")},
+		{Version: stringP("0.10.0")},
+	}
+
+	type test struct {
+		Order    changelog.ReleaseOrder
+		Expected []string
+	}
+
+	suite := map[string]test{
+		"SemVer": {
+			Order:    changelog.SemVerOrder,
+			Expected: []string{"2.0.1", "2.0.0", "1.9.5", "1.9.4", "0.10.0", "0.9.0"},
+		},
+		"Date": {
+			Order:    changelog.DateOrder,
+			Expected: []string{"2.0.1", "1.9.5", "2.0.0", "1.9.4", "0.10.0", "0.9.0"},
+		},
+		"Source": {
+			Order:    changelog.SourceOrder,
+			Expected: []string{"1.9.5", "2.0.0", "0.9.0", "1.9.4", "2.0.1", "0.10.0"},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		var versions []string
+		for _, r := range releases.Sorted(test.Order) {
+			versions = append(versions, *r.Version)
+		}
+
+		a.Equal(test.Expected, versions)
+		a.Equal("1.9.5", *releases[0].Version)
+	}
+}
+
 func TestReleasesGetRelease(t *testing.T) {
 	a := assert.New(t)
 
@@ -195,6 +242,41 @@ func TestReleasesCreateRelease(t *testing.T) {
 				Error: "",
 			},
 		},
+		"Backport": {
+			Releases: &changelog.Releases{
+				{
+					Version: stringP("1.9.0"),
+					Date:    parseDate("2021-05-19"),
+				},
+				{
+					Version: stringP("1.8.2"),
+					Date:    parseDate("2021-05-01"),
+				},
+			},
+			Version: "1.8.3",
+			Date:    "2021-05-30",
+			Expected: expected{
+				Release: &changelog.Release{
+					Version: stringP("1.8.3"),
+					Date:    parseDate("2021-05-30"),
+					Changes: new(changelog.Changes),
+				},
+			},
+		},
+		"Inconsistent Date": {
+			Releases: &changelog.Releases{
+				{
+					Version: stringP("1.8.2"),
+					Date:    parseDate("2021-05-01"),
+				},
+			},
+			Version: "1.8.3",
+			Date:    "2021-04-30",
+			Expected: expected{
+				Release: nil,
+				Error:   "date 2021-04-30 of release 1.8.3 is inconsistent with date 2021-05-01 of release 1.8.2",
+			},
+		},
 		"Existing Version": {
 			Releases: &changelog.Releases{
 				{
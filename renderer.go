@@ -0,0 +1,89 @@
+package changelog
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Renderer is an interface of a changelog output format.
+//
+// MarkdownRenderer is the default implementation.
+type Renderer interface {
+	RenderChangelog(w io.Writer, c *Changelog) error
+	RenderRelease(w io.Writer, r *Release) error
+	RenderChanges(w io.Writer, c *Changes) error
+}
+
+var (
+	renderersLock sync.RWMutex
+	renderers     = map[string]Renderer{
+		"markdown": new(MarkdownRenderer),
+		"asciidoc": new(AsciiDocRenderer),
+		"rst":      new(RSTRenderer),
+		"terminal": new(TerminalRenderer),
+	}
+)
+
+// RegisterRenderer makes a renderer available by a provided name.
+func RegisterRenderer(name string, renderer Renderer) error {
+	if name == "" {
+		return errors.New("renderer name must not be empty")
+	}
+
+	if renderer == nil {
+		return errors.New(fmt.Sprintf("renderer %v must not be nil", name))
+	}
+
+	renderersLock.Lock()
+	defer renderersLock.Unlock()
+
+	if _, ok := renderers[name]; ok {
+		return errors.New(fmt.Sprintf("renderer %v already registered", name))
+	}
+
+	renderers[name] = renderer
+	return nil
+}
+
+// GetRenderer returns a renderer registered under a provided name.
+func GetRenderer(name string) (Renderer, error) {
+	renderersLock.RLock()
+	defer renderersLock.RUnlock()
+
+	r, ok := renderers[name]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("renderer %v not registered", name))
+	}
+
+	return r, nil
+}
+
+// RendererNames returns sorted names of all registered renderers.
+func RendererNames() []string {
+	renderersLock.RLock()
+	defer renderersLock.RUnlock()
+
+	o := make([]string, 0, len(renderers))
+	for name := range renderers {
+		o = append(o, name)
+	}
+	sort.Strings(o)
+
+	return o
+}
+
+// countingWriter tracks the amount of bytes written for io.WriterTo implementations.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
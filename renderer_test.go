@@ -0,0 +1,256 @@
+package changelog_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+type plainRenderer struct{}
+
+func (p *plainRenderer) RenderChangelog(w io.Writer, c *changelog.Changelog) error {
+	for _, r := range c.Releases {
+		if err := p.RenderRelease(w, r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *plainRenderer) RenderRelease(w io.Writer, r *changelog.Release) error {
+	_, err := fmt.Fprintf(w, "%v\n", *r.Version)
+	return err
+}
+
+func (p *plainRenderer) RenderChanges(w io.Writer, c *changelog.Changes) error {
+	return nil
+}
+
+type failingWriter struct{}
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("reason")
+}
+
+func TestRegisterRenderer(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Name     string
+		Renderer changelog.Renderer
+		Expected string
+	}
+
+	suite := map[string]test{
+		"Empty Name": {
+			Name:     "",
+			Renderer: new(plainRenderer),
+			Expected: "renderer name must not be empty",
+		},
+		"Nil Renderer": {
+			Name:     "nil",
+			Renderer: nil,
+			Expected: "renderer nil must not be nil",
+		},
+		"Already Registered": {
+			Name:     "markdown",
+			Renderer: new(plainRenderer),
+			Expected: "renderer markdown already registered",
+		},
+		"Success": {
+			Name:     "plain",
+			Renderer: new(plainRenderer),
+			Expected: "",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		err := changelog.RegisterRenderer(test.Name, test.Renderer)
+		if test.Expected != "" || err != nil {
+			a.EqualError(err, test.Expected)
+		} else {
+			r, err := changelog.GetRenderer(test.Name)
+			a.Equal(nil, err)
+			a.Equal(test.Renderer, r)
+		}
+	}
+}
+
+func TestGetRenderer(t *testing.T) {
+	a := assert.New(t)
+
+	t.Log("Test Case 1/2 - Markdown")
+	r, err := changelog.GetRenderer("markdown")
+	a.Equal(nil, err)
+	a.Equal(new(changelog.MarkdownRenderer), r)
+
+	t.Log("Test Case 2/2 - Not Registered")
+	r, err = changelog.GetRenderer("unknown")
+	a.Nil(r)
+	a.EqualError(err, "renderer unknown not registered")
+}
+
+func TestRendererNames(t *testing.T) {
+	a := assert.New(t)
+
+	t.Log("Test Case 1/1 - Sorted Names")
+	names := changelog.RendererNames()
+	a.Contains(names, "markdown")
+	a.IsIncreasing(names)
+}
+
+func TestMarkdownRenderer(t *testing.T) {
+	a := assert.New(t)
+
+	tm1, _ := time.Parse(changelog.DateFormat, "2021-05-19")
+
+	release := &changelog.Release{
+		Version: stringP("0.0.1"),
+		URL:     stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v0.0.1"),
+		Date:    &tm1,
+		Changes: &changelog.Changes{
+			Notice: stringP("notice"),
+			Added: sliceOfStringsP([]string{
+				"A",
+			}),
+		},
+	}
+
+	c := &changelog.Changelog{
+		Title:    stringP("title"),
+		Releases: changelog.Releases{release},
+	}
+
+	m := new(changelog.MarkdownRenderer)
+
+	t.Log("Test Case 1/3 - Changelog")
+	b := new(bytes.Buffer)
+	a.Equal(nil, m.RenderChangelog(b, c))
+	a.Equal(c.ToString(), b.String())
+
+	t.Log("Test Case 2/3 - Release")
+	b.Reset()
+	a.Equal(nil, m.RenderRelease(b, release))
+	a.Equal(`## [0.0.1] - 2021-05-19
+
+notice
+
+### Added
+
+- A
+
+[0.0.1]: https://github.com/anton-yurchenko/go-changelog/releases/tag/v0.0.1`, b.String())
+
+	t.Log("Test Case 3/3 - Changes")
+	b.Reset()
+	a.Equal(nil, m.RenderChanges(b, release.Changes))
+	a.Equal(release.Changes.ToString(), b.String())
+}
+
+func TestWriteTo(t *testing.T) {
+	a := assert.New(t)
+
+	c := &changelog.Changelog{
+		Title: stringP("title"),
+		Releases: changelog.Releases{
+			{
+				Version: stringP("1.0.0"),
+			},
+		},
+	}
+
+	t.Log("Test Case 1/3 - Markdown")
+	b := new(bytes.Buffer)
+	n, err := c.WriteTo(b)
+	a.Equal(nil, err)
+	a.Equal("# title\n\n## [1.0.0]\n", b.String())
+	a.Equal(int64(b.Len()), n)
+
+	t.Log("Test Case 2/3 - Custom Renderer")
+	b.Reset()
+	n, err = c.WriteToWithRenderer(b, new(plainRenderer))
+	a.Equal(nil, err)
+	a.Equal("1.0.0\n", b.String())
+	a.Equal(int64(6), n)
+
+	t.Log("Test Case 3/3 - Writer Error")
+	n, err = c.WriteTo(new(failingWriter))
+	a.EqualError(err, "reason")
+	a.Equal(int64(0), n)
+}
+
+func TestSaveToFileWithRenderer(t *testing.T) {
+	a := assert.New(t)
+	fs := afero.NewMemMapFs()
+
+	c := &changelog.Changelog{
+		Releases: changelog.Releases{
+			{
+				Version: stringP("1.0.0"),
+			},
+		},
+	}
+
+	t.Log("Test Case 1/1 - Custom Renderer")
+	a.Equal(nil, c.SaveToFileWithRenderer(fs, "CHANGELOG.txt", new(plainRenderer)))
+
+	content, err := afero.ReadFile(fs, "CHANGELOG.txt")
+	a.Equal(nil, err)
+	a.Equal("1.0.0\n", string(content))
+}
+
+func TestRenderersScopeOrder(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Renderer changelog.Renderer
+		First    string
+	}
+
+	changes := &changelog.Changes{
+		Added:    sliceOfStringsP([]string{"A"}),
+		Security: sliceOfStringsP([]string{"B"}),
+		Order:    changelog.ScopeOrder{"Added", "Security"},
+	}
+
+	suite := map[string]test{
+		"AsciiDoc Default":          {Renderer: new(changelog.AsciiDocRenderer), First: "Security"},
+		"AsciiDoc Keep a Changelog": {Renderer: &changelog.AsciiDocRenderer{ScopeOrder: changelog.KeepAChangelogScopeOrder}, First: "Added"},
+		"AsciiDoc Preserved":        {Renderer: &changelog.AsciiDocRenderer{PreserveScopeOrder: true}, First: "Added"},
+		"RST Default":               {Renderer: new(changelog.RSTRenderer), First: "Security"},
+		"RST Keep a Changelog":      {Renderer: &changelog.RSTRenderer{ScopeOrder: changelog.KeepAChangelogScopeOrder}, First: "Added"},
+		"RST Preserved":             {Renderer: &changelog.RSTRenderer{PreserveScopeOrder: true}, First: "Added"},
+		"Terminal Default":          {Renderer: &changelog.TerminalRenderer{NoColor: true}, First: "Security"},
+		"Terminal Keep a Changelog": {Renderer: &changelog.TerminalRenderer{NoColor: true, ScopeOrder: changelog.KeepAChangelogScopeOrder}, First: "Added"},
+		"Terminal Preserved":        {Renderer: &changelog.TerminalRenderer{NoColor: true, PreserveScopeOrder: true}, First: "Added"},
+		"Debian Default":            {Renderer: new(changelog.DebianRenderer), First: "Security"},
+		"Debian Keep a Changelog":   {Renderer: &changelog.DebianRenderer{ScopeOrder: changelog.KeepAChangelogScopeOrder}, First: "Added"},
+		"Debian Preserved":          {Renderer: &changelog.DebianRenderer{PreserveScopeOrder: true}, First: "Added"},
+		"RPM Default":               {Renderer: new(changelog.RPMRenderer), First: "Security"},
+		"RPM Keep a Changelog":      {Renderer: &changelog.RPMRenderer{ScopeOrder: changelog.KeepAChangelogScopeOrder}, First: "Added"},
+		"RPM Preserved":             {Renderer: &changelog.RPMRenderer{PreserveScopeOrder: true}, First: "Added"},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		b := new(bytes.Buffer)
+		a.Equal(nil, test.Renderer.RenderChanges(b, changes))
+		a.Regexp(`^\W*`+test.First, b.String())
+	}
+}
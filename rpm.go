@@ -0,0 +1,137 @@
+package changelog
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// RPMRenderer renders releases of a changelog as a %changelog section of an RPM spec file.
+//
+// Every entry is prefixed by its scope, for example: "- Fixed: entry".
+// Notices are rendered as entries without a scope.
+// Unreleased section is omitted.
+type RPMRenderer struct {
+	// Packager is expected in "Full Name <email>" format.
+	Packager string
+	// Release is an RPM release appended to every version, defaults to "1".
+	Release string
+	// ScopeOrder defaults to DefaultScopeOrder.
+	ScopeOrder ScopeOrder
+	// PreserveScopeOrder renders scopes of parsed changes in order of their appearance in a file.
+	PreserveScopeOrder bool
+}
+
+// RenderChangelog writes a %changelog section with all releases.
+//
+// RPM requires entries in a descending chronological order,
+// hence releases are sorted by their date and then by their Semantic Version.
+func (r *RPMRenderer) RenderChangelog(w io.Writer, c *Changelog) error {
+	releases := make(Releases, len(c.Releases))
+	copy(releases, c.Releases)
+
+	for _, release := range releases {
+		if release.Date == nil {
+			return errors.New(fmt.Sprintf("missing date of release %v", *release.Version))
+		}
+	}
+
+	sort.SliceStable(releases, func(i, j int) bool {
+		if releases[i].Date.Equal(*releases[j].Date) {
+			return releases.Less(j, i)
+		}
+
+		return releases[i].Date.After(*releases[j].Date)
+	})
+
+	if _, err := io.WriteString(w, "%changelog\n"); err != nil {
+		return err
+	}
+
+	for i, release := range releases {
+		if i != 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+
+		if err := r.RenderRelease(w, release); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RenderRelease writes a single %changelog entry of a release.
+func (r *RPMRenderer) RenderRelease(w io.Writer, release *Release) error {
+	if r.Packager == "" {
+		return errors.New("missing packager")
+	}
+
+	if release.Version == nil {
+		return errors.New("missing release version")
+	}
+
+	if release.Date == nil {
+		return errors.New(fmt.Sprintf("missing date of release %v", *release.Version))
+	}
+
+	o := fmt.Sprintf("* %v %v - %v\n",
+		release.Date.Format(RPMDateFormat),
+		r.Packager,
+		packageVersion(*release.Version, valueOrDefault(r.Release, "1")),
+	)
+
+	if release.Changes != nil {
+		o += r.changes(release.Changes)
+	}
+
+	_, err := io.WriteString(w, o)
+	return err
+}
+
+// RenderChanges writes entries of changes.
+func (r *RPMRenderer) RenderChanges(w io.Writer, c *Changes) error {
+	_, err := io.WriteString(w, r.changes(c))
+	return err
+}
+
+func (r *RPMRenderer) changes(c *Changes) string {
+	var o []string
+
+	if c.Notice != nil {
+		o = append(o, rpmEntry(*c.Notice))
+	}
+
+	for _, s := range c.orderedScopes(r.ScopeOrder, r.PreserveScopeOrder) {
+		if s.entries != nil {
+			for _, e := range *s.entries {
+				o = append(o, rpmEntry(fmt.Sprintf("%v: %v", s.name, e)))
+			}
+		}
+	}
+
+	if len(o) == 0 {
+		return ""
+	}
+
+	return strings.Join(o, "\n") + "\n"
+}
+
+// rpmEntry formats a single entry, escaping macros expansion.
+func rpmEntry(entry string) string {
+	lines := strings.Split(strings.ReplaceAll(entry, "%", "%%"), "\n")
+	for i, l := range lines {
+		if i == 0 {
+			lines[i] = fmt.Sprintf("- %v", l)
+		} else {
+			lines[i] = fmt.Sprintf("  %v", l)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
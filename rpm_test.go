@@ -0,0 +1,148 @@
+package changelog_test
+
+import (
+	"bytes"
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPMRenderer(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Renderer  *changelog.RPMRenderer
+		Changelog *changelog.Changelog
+		Expected  string
+		Error     string
+	}
+
+	renderer := &changelog.RPMRenderer{
+		Packager: "Anton Yurchenko <anton@example.com>",
+	}
+
+	suite := map[string]test{
+		"Empty": {
+			Renderer:  renderer,
+			Changelog: changelog.NewChangelog(),
+			Expected:  "%changelog\n",
+		},
+		"Missing Packager": {
+			Renderer: new(changelog.RPMRenderer),
+			Changelog: &changelog.Changelog{
+				Releases: changelog.Releases{
+					{
+						Version: stringP("1.0.0"),
+						Date:    dateP("2021-05-19"),
+					},
+				},
+			},
+			Error: "missing packager",
+		},
+		"Missing Date": {
+			Renderer: renderer,
+			Changelog: &changelog.Changelog{
+				Releases: changelog.Releases{
+					{
+						Version: stringP("1.0.0"),
+					},
+				},
+			},
+			Error: "missing date of release 1.0.0",
+		},
+		"Chronological Order": {
+			Renderer: &changelog.RPMRenderer{
+				Packager: "Anton Yurchenko <anton@example.com>",
+				Release:  "2.fc40",
+			},
+			Changelog: &changelog.Changelog{
+				Unreleased: &changelog.Release{
+					Changes: &changelog.Changes{
+						Added: sliceOfStringsP([]string{"ignored"}),
+					},
+				},
+				Releases: changelog.Releases{
+					{
+						Version: stringP("1.9.5"),
+						Date:    dateP("2024-10-16"),
+						Changes: &changelog.Changes{
+							Security: sliceOfStringsP([]string{"100% safe\nmultiline"}),
+						},
+					},
+					{
+						Version: stringP("1.0.0"),
+						Date:    dateP("2024-01-05"),
+						Changes: &changelog.Changes{
+							Notice: stringP("notice"),
+							Added:  sliceOfStringsP([]string{"A"}),
+						},
+					},
+					{
+						Version: stringP("2.0.0-rc.1"),
+						Date:    dateP("2024-10-01"),
+					},
+					{
+						Version: stringP("2.0.0"),
+						Date:    dateP("2024-10-01"),
+					},
+				},
+			},
+			Expected: `%changelog
+* Wed Oct 16 2024 Anton Yurchenko <anton@example.com> - 1.9.5-2.fc40
+- Security: 100%% safe
+  multiline
+
+* Tue Oct 01 2024 Anton Yurchenko <anton@example.com> - 2.0.0-2.fc40
+
+* Tue Oct 01 2024 Anton Yurchenko <anton@example.com> - 2.0.0~rc.1-2.fc40
+
+* Fri Jan 05 2024 Anton Yurchenko <anton@example.com> - 1.0.0-2.fc40
+- notice
+- Added: A
+`,
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		b := new(bytes.Buffer)
+		err := test.Renderer.RenderChangelog(b, test.Changelog)
+		if test.Error != "" || err != nil {
+			a.EqualError(err, test.Error)
+		} else {
+			a.Equal(test.Expected, b.String())
+		}
+	}
+}
+
+func TestRPMRendererRelease(t *testing.T) {
+	a := assert.New(t)
+
+	r := &changelog.RPMRenderer{
+		Packager: "Anton Yurchenko <anton@example.com>",
+	}
+
+	t.Log("Test Case 1/3 - Defaults")
+	b := new(bytes.Buffer)
+	a.Equal(nil, r.RenderRelease(b, &changelog.Release{
+		Version: stringP("1.0.0"),
+		Date:    dateP("2021-05-19"),
+		Changes: &changelog.Changes{
+			Fixed: sliceOfStringsP([]string{"A"}),
+		},
+	}))
+	a.Equal("* Wed May 19 2021 Anton Yurchenko <anton@example.com> - 1.0.0-1\n- Fixed: A\n", b.String())
+
+	t.Log("Test Case 2/3 - Unreleased")
+	a.EqualError(r.RenderRelease(b, new(changelog.Release)), "missing release version")
+
+	t.Log("Test Case 3/3 - Changes")
+	b.Reset()
+	a.Equal(nil, r.RenderChanges(b, new(changelog.Changes)))
+	a.Equal("", b.String())
+}
@@ -0,0 +1,148 @@
+package changelog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// RSTRenderer renders a changelog as reStructuredText.
+//
+// Every release section has a label (for example: .. _release-1.0.0:),
+// release URLs are defined as hyperlink targets (for example: .. _1.0.0: <url>)
+// and a reason of a yanked release is rendered as a warning directive.
+type RSTRenderer struct {
+	// ScopeOrder defaults to DefaultScopeOrder.
+	ScopeOrder ScopeOrder
+	// PreserveScopeOrder renders scopes of parsed changes in order of their appearance in a file.
+	PreserveScopeOrder bool
+}
+
+// RenderChangelog writes a reStructuredText formatted Changelog struct.
+func (r *RSTRenderer) RenderChangelog(w io.Writer, c *Changelog) error {
+	var o []string
+	var defs []string
+
+	if c.Title != nil {
+		o = append(o, fmt.Sprintf("%v\n", rstHeading(*c.Title, "=")))
+	}
+
+	if c.Description != nil {
+		o = append(o, fmt.Sprintf("%v\n", *c.Description))
+	}
+
+	releases := make(Releases, 0)
+	if c.Unreleased != nil {
+		releases = append(releases, c.Unreleased)
+	}
+	releases = append(releases, c.Releases.descending()...)
+
+	for _, release := range releases {
+		s, d := r.release(release)
+		o = append(o, s)
+		if d != "" {
+			defs = append(defs, d)
+		}
+	}
+
+	if len(defs) > 0 {
+		o = append(o, fmt.Sprintf("%v\n", strings.Join(defs, "\n")))
+	}
+
+	_, err := io.WriteString(w, strings.Join(o, "\n"))
+	return err
+}
+
+// RenderRelease writes a reStructuredText formatted Release struct followed by its hyperlink target.
+func (r *RSTRenderer) RenderRelease(w io.Writer, release *Release) error {
+	o, d := r.release(release)
+	if d != "" {
+		o = strings.Join([]string{o, fmt.Sprintf("%v\n", d)}, "\n")
+	}
+
+	_, err := io.WriteString(w, o)
+	return err
+}
+
+// RenderChanges writes a reStructuredText formatted Changes struct.
+func (r *RSTRenderer) RenderChanges(w io.Writer, c *Changes) error {
+	_, err := io.WriteString(w, r.changes(c))
+	return err
+}
+
+func (r *RSTRenderer) release(release *Release) (string, string) {
+	var o []string
+	var d string
+
+	name := "Unreleased"
+	if release.Version != nil {
+		name = *release.Version
+	}
+
+	title := name
+	if release.URL != nil {
+		title = fmt.Sprintf("`%v`_", name)
+		d = fmt.Sprintf(".. _%v: %v", name, *release.URL)
+	}
+
+	if release.Version != nil && release.Date != nil {
+		title = fmt.Sprintf("%v - %v", title, formatDate(release.Date))
+	}
+
+	if release.Yanked {
+		title = fmt.Sprintf("%v [YANKED]", title)
+	}
+
+	o = append(o, fmt.Sprintf(".. _%v:\n", releaseAnchor(name)), fmt.Sprintf("%v\n", rstHeading(title, "-")))
+
+	if release.Yanked && release.YankReason != nil {
+		o = append(o, fmt.Sprintf(".. warning::\n\n   Yanked: %v\n", *release.YankReason))
+	}
+
+	if release.Changes != nil {
+		o = append(o, r.changes(release.Changes))
+	}
+
+	return strings.Join(o, "\n"), d
+}
+
+func (r *RSTRenderer) changes(c *Changes) string {
+	var o []string
+	if c.Notice != nil {
+		o = append(o, fmt.Sprintf("%v\n", *c.Notice))
+	}
+
+	for _, s := range c.orderedScopes(r.ScopeOrder, r.PreserveScopeOrder) {
+		if s.entries != nil {
+			var entries []string
+			for _, e := range *s.entries {
+				entries = append(entries, rstEntry(e))
+			}
+
+			o = append(o, fmt.Sprintf("%v\n", rstHeading(s.name, "~")), fmt.Sprintf("%v\n", strings.Join(entries, "\n")))
+		}
+	}
+
+	return strings.Join(o, "\n")
+}
+
+// rstHeading underlines a title with an adornment character.
+func rstHeading(title, adornment string) string {
+	return fmt.Sprintf("%v\n%v", title, strings.Repeat(adornment, utf8.RuneCountInString(title)))
+}
+
+// rstEntry formats a bullet list item, aligning following lines with its text.
+func rstEntry(entry string) string {
+	lines := strings.Split(entry, "\n")
+	for i, l := range lines {
+		switch {
+		case i == 0:
+			lines[i] = fmt.Sprintf("- %v", l)
+		case l != "":
+			lines[i] = fmt.Sprintf("  %v", l)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,126 @@
+package changelog_test
+
+import (
+	"bytes"
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRSTRenderer(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Changelog *changelog.Changelog
+		Expected  string
+	}
+
+	suite := map[string]test{
+		"Empty": {
+			Changelog: new(changelog.Changelog),
+			Expected:  "",
+		},
+		"Title": {
+			Changelog: &changelog.Changelog{
+				Title: stringP("Журнал"),
+			},
+			Expected: "Журнал\n======\n",
+		},
+		"Full": {
+			Changelog: documentationChangelog(),
+			Expected: `Changelog
+=========
+
+description
+
+.. _release-unreleased:
+
+` + "`Unreleased`_" + `
+-------------
+
+Added
+~~~~~
+
+- A
+
+.. _release-1.1.0-rc.1:
+
+1.1.0-rc.1
+----------
+
+.. _release-1.0.0:
+
+` + "`1.0.0`_" + ` - 2021-05-19
+---------------------
+
+notice
+
+Security
+~~~~~~~~
+
+- C
+
+Fixed
+~~~~~
+
+- B
+
+  code
+
+.. _Unreleased: https://github.com/anton-yurchenko/go-changelog/compare/v1.0.0...HEAD
+.. _1.0.0: https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0
+`,
+		},
+		"Yanked": {
+			Changelog: &changelog.Changelog{
+				Releases: changelog.Releases{
+					{
+						Version:    stringP("1.0.0"),
+						Date:       dateP("2021-05-19"),
+						Yanked:     true,
+						YankReason: stringP("broken build"),
+						Changes:    &changelog.Changes{Fixed: sliceOfStringsP([]string{"A"})},
+					},
+					{
+						Version: stringP("0.9.0"),
+						Date:    dateP("2021-05-01"),
+						Yanked:  true,
+					},
+				},
+			},
+			Expected: ".. _release-1.0.0:\n\n1.0.0 - 2021-05-19 [YANKED]\n---------------------------\n\n.. warning::\n\n   Yanked: broken build\n\nFixed\n~~~~~\n\n- A\n\n.. _release-0.9.0:\n\n0.9.0 - 2021-05-01 [YANKED]\n---------------------------\n",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		b := new(bytes.Buffer)
+		a.Equal(nil, new(changelog.RSTRenderer).RenderChangelog(b, test.Changelog))
+		a.Equal(test.Expected, b.String())
+	}
+}
+
+func TestRSTRendererRelease(t *testing.T) {
+	a := assert.New(t)
+	r := new(changelog.RSTRenderer)
+
+	t.Log("Test Case 1/2 - Release")
+	b := new(bytes.Buffer)
+	a.Equal(nil, r.RenderRelease(b, &changelog.Release{
+		Version: stringP("1.0.0"),
+		URL:     stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0"),
+	}))
+	a.Equal(".. _release-1.0.0:\n\n`1.0.0`_\n--------\n\n.. _1.0.0: https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0\n", b.String())
+
+	t.Log("Test Case 2/2 - Changes")
+	b.Reset()
+	a.Equal(nil, r.RenderChanges(b, &changelog.Changes{
+		Removed: sliceOfStringsP([]string{"A"}),
+	}))
+	a.Equal("Removed\n~~~~~~~\n\n- A\n", b.String())
+}
@@ -0,0 +1,240 @@
+package changelog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultTemplate contains built-in templates equivalent to the Markdown output of ToString functions.
+//
+// Defined templates:
+//   - "changelog": executed with TemplateData, equivalent to Changelog.ToString
+//   - "release": executed with *Release, equivalent to the first value of Release.ToString
+//   - "definition": executed with *Release, equivalent to the second value of Release.ToString
+//   - "changes": executed with *Changes, equivalent to Changes.ToString
+const DefaultTemplate string = `{{define "changes" -}}
+{{- $sep := "" -}}
+{{- with .Notice}}{{.}}{{"\n"}}{{$sep = "\n"}}{{end -}}
+{{- range scopes .}}{{$sep}}### {{.Name}}{{"\n\n"}}{{range $i, $e := .Entries}}{{if $i}}{{"\n"}}{{end}}- {{entry $e}}{{end}}{{"\n"}}{{$sep = "\n"}}{{end -}}
+{{- end}}
+
+{{- define "release" -}}
+{{- if .Version}}## [{{.Version}}]{{with .Date}} - {{date .}}{{end}}{{else}}## [Unreleased]{{end}}{{if .Yanked}} [YANKED]{{end}}{{"\n"}}
+{{- if .Yanked}}{{with .YankReason}}{{"\n"}}> Yanked: {{.}}{{"\n"}}{{end}}{{end -}}
+{{- with .Changes}}{{"\n"}}{{template "changes" .}}{{end -}}
+{{- end}}
+
+{{- define "definition" -}}
+{{- with .URL}}[{{with $.Version}}{{.}}{{else}}Unreleased{{end}}]: {{.}}{{end -}}
+{{- end}}
+
+{{- define "changelog" -}}
+{{- $sep := "" -}}
+{{- with .Title}}# {{.}}{{"\n"}}{{$sep = "\n"}}{{end -}}
+{{- with .Description}}{{$sep}}{{.}}{{"\n"}}{{$sep = "\n"}}{{end -}}
+{{- with .Unreleased}}{{$sep}}{{template "release" .}}{{$sep = "\n"}}{{end -}}
+{{- range .Releases}}{{$sep}}{{template "release" .}}{{$sep = "\n"}}{{end -}}
+{{- $defs := "\n" -}}
+{{- with .Unreleased}}{{if .URL}}{{$sep}}{{template "definition" .}}{{$sep = "\n"}}{{$defs = ""}}{{end}}{{end -}}
+{{- range .Releases}}{{if .URL}}{{if $defs}}{{$sep}}{{else}}{{"\n"}}{{end}}{{template "definition" .}}{{$sep = "\n"}}{{$defs = ""}}{{end}}{{end -}}
+{{- end}}`
+
+// TemplateData is a data model of a changelog passed to templates.
+type TemplateData struct {
+	Title       *string
+	Description *string
+	Unreleased  *Release
+	// Releases are sorted by their Semantic Version in a descending order.
+	Releases Releases
+}
+
+// TemplateScope is a single non empty scope of changes as returned by "scopes" template function.
+type TemplateScope struct {
+	Name    string
+	Entries []string
+}
+
+// TemplateFuncs returns helper functions available in templates:
+//   - date: formats a date as YYYY-MM-DD
+//   - formatDate: formats a date according to a provided Go time layout
+//   - scopes: returns non empty scopes of changes sorted by their importance, or by an order of provided scopes
+//     (for example: {{scopes . "added" "changed"}}), a leading "preserve" sorts scopes in order of their appearance in a file first
+//   - links: returns a LinkProvider of a repository URL with a detected forge,
+//     or a forge provided by name: github, gitlab, bitbucket or gitea (for example: {{(links "https://git.example.com/o/r" "gitlab").TagURL "1.0.0"}})
+//   - compareURL: builds a compare URL of a repository between two git references (see LinkProvider.CompareURL)
+//   - escape: escapes Markdown special characters
+//   - entry: formats a multi-line entry as a list item content, as done by ToString
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"date":       formatDate,
+		"formatDate": formatDateWithLayout,
+		"scopes":     templateScopes,
+		"links":      templateLinks,
+		"compareURL": compareURL,
+		"escape":     escapeMarkdown,
+		"entry":      formatEntry,
+	}
+}
+
+func newTemplateData(c *Changelog) *TemplateData {
+	return &TemplateData{
+		Title:       c.Title,
+		Description: c.Description,
+		Unreleased:  c.Unreleased,
+		Releases:    c.Releases.descending(),
+	}
+}
+
+func formatDateWithLayout(layout string, date *time.Time) string {
+	return date.Format(layout)
+}
+
+func templateScopes(c *Changes, order ...string) ([]TemplateScope, error) {
+	preserve := len(order) > 0 && order[0] == "preserve"
+	if preserve {
+		order = order[1:]
+	}
+
+	scopes, err := NewScopeOrder(order...)
+	if err != nil {
+		return nil, err
+	}
+
+	o := make([]TemplateScope, 0)
+
+	for _, s := range c.orderedScopes(scopes, preserve) {
+		if s.entries != nil {
+			o = append(o, TemplateScope{Name: s.name, Entries: *s.entries})
+		}
+	}
+
+	return o, nil
+}
+
+func templateLinks(repository string, forge ...string) (*LinkProvider, error) {
+	if len(forge) == 0 {
+		return NewLinkProvider(repository)
+	}
+
+	if len(forge) > 1 {
+		return nil, errors.New(fmt.Sprintf("unexpected amount of forges: %v", len(forge)))
+	}
+
+	f, ok := map[string]Forge{
+		"github":    GitHub,
+		"gitlab":    GitLab,
+		"bitbucket": Bitbucket,
+		"gitea":     Gitea,
+	}[strings.ToLower(forge[0])]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("unexpected forge: %v (supported: [github,gitlab,bitbucket,gitea])", forge[0]))
+	}
+
+	return &LinkProvider{Forge: f, Repository: repository}, nil
+}
+
+func compareURL(repository, from, to string) (string, error) {
+	p, err := NewLinkProvider(repository)
+	if err != nil {
+		return "", err
+	}
+
+	return p.CompareURL(from, to), nil
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+	`>`, `\>`,
+	`#`, `\#`,
+	`|`, `\|`,
+)
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// TemplateRenderer renders a changelog using text/template templates.
+//
+// RenderChangelog, RenderRelease and RenderChanges execute templates named
+// "changelog", "release" and "changes" respectively.
+// Templates that are not redefined by a user fall back to DefaultTemplate.
+type TemplateRenderer struct {
+	template *template.Template
+}
+
+// NewTemplateRenderer creates a new TemplateRenderer from a user supplied template.
+//
+// The template may redefine any of the templates of DefaultTemplate,
+// for example: {{define "release"}}...{{end}}.
+func NewTemplateRenderer(text string) (*TemplateRenderer, error) {
+	t, err := parseTemplate(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateRenderer{template: t}, nil
+}
+
+func parseTemplate(text string) (*template.Template, error) {
+	t, err := template.New("default").Funcs(TemplateFuncs()).Parse(DefaultTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing default template")
+	}
+
+	t, err = t.New("custom").Parse(text)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing template")
+	}
+
+	return t, nil
+}
+
+// RenderChangelog executes "changelog" template with TemplateData.
+func (t *TemplateRenderer) RenderChangelog(w io.Writer, c *Changelog) error {
+	return t.template.ExecuteTemplate(w, "changelog", newTemplateData(c))
+}
+
+// RenderRelease executes "release" template with a release.
+func (t *TemplateRenderer) RenderRelease(w io.Writer, r *Release) error {
+	return t.template.ExecuteTemplate(w, "release", r)
+}
+
+// RenderChanges executes "changes" template with changes.
+func (t *TemplateRenderer) RenderChanges(w io.Writer, c *Changes) error {
+	return t.template.ExecuteTemplate(w, "changes", c)
+}
+
+// RenderTemplate executes a user supplied template with TemplateData of a changelog.
+//
+// Templates of DefaultTemplate are available within the template.
+func RenderTemplate(w io.Writer, text string, c *Changelog) error {
+	t, err := parseTemplate(text)
+	if err != nil {
+		return err
+	}
+
+	return t.Execute(w, newTemplateData(c))
+}
+
+// RenderReleaseTemplate executes a user supplied template with a release.
+//
+// Templates of DefaultTemplate are available within the template.
+func RenderReleaseTemplate(w io.Writer, text string, r *Release) error {
+	t, err := parseTemplate(text)
+	if err != nil {
+		return err
+	}
+
+	return t.Execute(w, r)
+}
@@ -0,0 +1,254 @@
+package changelog_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultTemplate(t *testing.T) {
+	a := assert.New(t)
+
+	tm1, _ := time.Parse(changelog.DateFormat, "2021-05-19")
+	tm2, _ := time.Parse(changelog.DateFormat, "2021-05-22")
+
+	suite := map[string]*changelog.Changelog{
+		"Empty": new(changelog.Changelog),
+		"Title": {
+			Title: stringP("title"),
+		},
+		"Description": {
+			Description: stringP("description\nhere"),
+		},
+		"Empty Unreleased": {
+			Title:      stringP("title"),
+			Unreleased: new(changelog.Release),
+		},
+		"Release Without Changes": {
+			Releases: changelog.Releases{
+				{
+					Version: stringP("0.0.1"),
+				},
+			},
+		},
+		"Full": {
+			Title:       stringP("title"),
+			Description: stringP("description\nhere"),
+			Unreleased: &changelog.Release{
+				URL: stringP("https://github.com/anton-yurchenko/go-changelog/compare/v0.0.2...HEAD"),
+				Changes: &changelog.Changes{
+					Added: sliceOfStringsP([]string{
+						"A",
+					}),
+				},
+			},
+			Releases: changelog.Releases{
+				{
+					Version: stringP("0.0.1"),
+					URL:     stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v0.0.1"),
+					Date:    &tm1,
+					Changes: &changelog.Changes{
+						Notice: stringP("notice"),
+						Added: sliceOfStringsP([]string{
+							"A",
+							"B",
+						}),
+						Security: sliceOfStringsP([]string{
+							"A",
+						}),
+					},
+				},
+				{
+					Version:    stringP("0.0.2"),
+					Date:       &tm2,
+					Yanked:     true,
+					YankReason: stringP("broken build"),
+					Changes: &changelog.Changes{
+						Fixed: sliceOfStringsP([]string{
+							"A",
+						}),
+						Deprecated: sliceOfStringsP([]string{
+							"A",
+						}),
+					},
+				},
+			},
+		},
+	}
+
+	r, err := changelog.NewTemplateRenderer("")
+	if err != nil {
+		t.Fatalf("error preparing test: %v", err)
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		b := new(bytes.Buffer)
+		a.Equal(nil, r.RenderChangelog(b, test))
+		a.Equal(test.ToString(), b.String())
+
+		for _, release := range test.Releases {
+			expected, _ := release.ToString()
+
+			b.Reset()
+			a.Equal(nil, r.RenderRelease(b, release))
+			a.Equal(expected, b.String())
+
+			if release.Changes != nil {
+				b.Reset()
+				a.Equal(nil, r.RenderChanges(b, release.Changes))
+				a.Equal(release.Changes.ToString(), b.String())
+			}
+		}
+	}
+}
+
+func TestNewTemplateRenderer(t *testing.T) {
+	a := assert.New(t)
+
+	t.Log("Test Case 1/2 - Invalid Template")
+	r, err := changelog.NewTemplateRenderer("{{define}}")
+	a.Nil(r)
+	a.ErrorContains(err, "error parsing template: template: custom:1:")
+
+	t.Log("Test Case 2/2 - Redefined Template")
+	r, err = changelog.NewTemplateRenderer(`{{define "release"}}**{{.Version}}**{{end}}`)
+	a.Equal(nil, err)
+
+	b := new(bytes.Buffer)
+	a.Equal(nil, r.RenderRelease(b, &changelog.Release{Version: stringP("1.0.0")}))
+	a.Equal("**1.0.0**", b.String())
+
+	b.Reset()
+	a.Equal(nil, r.RenderChanges(b, &changelog.Changes{Fixed: sliceOfStringsP([]string{"A"})}))
+	a.Equal("### Fixed\n\n- A\n", b.String())
+}
+
+func TestRenderTemplate(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		Template string
+		Expected string
+		Error    string
+	}
+
+	tm1, _ := time.Parse(changelog.DateFormat, "2021-05-19")
+
+	c := &changelog.Changelog{
+		Title: stringP("Changelog"),
+		Releases: changelog.Releases{
+			{
+				Version: stringP("1.0.0"),
+				Date:    &tm1,
+				Changes: &changelog.Changes{
+					Fixed: sliceOfStringsP([]string{"A"}),
+					Added: sliceOfStringsP([]string{"B_C"}),
+				},
+			},
+			{
+				Version: stringP("1.1.0"),
+				Date:    &tm1,
+			},
+		},
+	}
+
+	suite := map[string]test{
+		"Default Subtemplates": {
+			Template: `{{range .Releases}}{{template "release" .}}{{end}}`,
+			Expected: "## [1.1.0] - 2021-05-19\n## [1.0.0] - 2021-05-19\n\n### Added\n\n- B_C\n\n### Fixed\n\n- A\n",
+		},
+		"Emoji Per Scope": {
+			Template: `{{range .Releases}}{{with .Changes}}{{range scopes .}}{{if eq .Name "Added"}}✨{{else}}🐛{{end}}{{range .Entries}} {{escape .}}{{end}}{{"\n"}}{{end}}{{end}}{{end}}`,
+			Expected: "✨ B\\_C\n🐛 A\n",
+		},
+		"Scope Order": {
+			Template: `{{range .Releases}}{{with .Changes}}{{range scopes . "fixed"}}{{.Name}};{{end}}{{end}}{{end}}`,
+			Expected: "Fixed;Added;",
+		},
+		"Invalid Scope Order": {
+			Template: `{{range .Releases}}{{with .Changes}}{{range scopes . "unknown"}}{{.Name}};{{end}}{{end}}{{end}}`,
+			Error:    "unexpected scope: unknown",
+		},
+		"Compare URL": {
+			Template: `{{compareURL "https://github.com/owner/repository/" "v1.0.0" "v1.1.0"}}`,
+			Expected: "https://github.com/owner/repository/compare/v1.0.0...v1.1.0",
+		},
+		"GitLab Compare URL": {
+			Template: `{{compareURL "https://gitlab.com/owner/repository" "v1.0.0" "v1.1.0"}}`,
+			Expected: "https://gitlab.com/owner/repository/-/compare/v1.0.0...v1.1.0",
+		},
+		"Bitbucket Compare URL": {
+			Template: `{{compareURL "https://bitbucket.org/owner/repository" "v1.0.0" "v1.1.0"}}`,
+			Expected: "https://bitbucket.org/owner/repository/branches/compare/v1.1.0%0Dv1.0.0",
+		},
+		"Unknown Forge Compare URL": {
+			Template: `{{compareURL "https://git.example.com/owner/repository" "v1.0.0" "v1.1.0"}}`,
+			Error:    "unable to detect a forge of https://git.example.com/owner/repository",
+		},
+		"Links": {
+			Template: `{{with links "https://git.example.com/owner/repository" "gitlab"}}{{.TagURL "1.0.0"}} {{.UnreleasedURL "1.0.0"}}{{end}}`,
+			Expected: "https://git.example.com/owner/repository/-/tags/v1.0.0 https://git.example.com/owner/repository/-/compare/v1.0.0...HEAD",
+		},
+		"Links Invalid Forge": {
+			Template: `{{links "https://git.example.com/owner/repository" "svn"}}`,
+			Error:    "unexpected forge: svn (supported: [github,gitlab,bitbucket,gitea])",
+		},
+		"Format Date": {
+			Template: `{{range .Releases}}{{formatDate "Jan 2, 2006" .Date}};{{end}}`,
+			Expected: "May 19, 2021;May 19, 2021;",
+		},
+		"Invalid Template": {
+			Template: `{{if}}`,
+			Error:    "error parsing template: template: custom:1:",
+		},
+		"Execution Error": {
+			Template: `{{template "unknown"}}`,
+			Error:    `template: custom:1:11: executing "custom"`,
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		b := new(bytes.Buffer)
+		err := changelog.RenderTemplate(b, test.Template, c)
+		if test.Error != "" || err != nil {
+			a.ErrorContains(err, test.Error)
+		} else {
+			a.Equal(test.Expected, b.String())
+		}
+	}
+
+	t.Log("Releases are not reordered")
+	a.Equal("1.0.0", *c.Releases[0].Version)
+}
+
+func TestRenderReleaseTemplate(t *testing.T) {
+	a := assert.New(t)
+
+	tm1, _ := time.Parse(changelog.DateFormat, "2021-05-19")
+
+	r := &changelog.Release{
+		Version: stringP("1.0.0"),
+		Date:    &tm1,
+		URL:     stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0"),
+	}
+
+	t.Log("Test Case 1/2 - Bold Version Without Definitions")
+	b := new(bytes.Buffer)
+	a.Equal(nil, changelog.RenderReleaseTemplate(b, `## **{{.Version}}** ({{date .Date}})`, r))
+	a.Equal("## **1.0.0** (2021-05-19)", b.String())
+
+	t.Log("Test Case 2/2 - Invalid Template")
+	a.ErrorContains(changelog.RenderReleaseTemplate(b, `{{end}}`, r), "error parsing template: template: custom:1:")
+}
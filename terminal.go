@@ -0,0 +1,190 @@
+package changelog
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// ANSI escape sequences used by TerminalRenderer.
+const (
+	ansiReset     string = "\033[0m"
+	ansiBold      string = "\033[1m"
+	ansiDim       string = "\033[2m"
+	ansiUnderline string = "\033[4m"
+	ansiRed       string = "\033[31m"
+	ansiGreen     string = "\033[32m"
+	ansiYellow    string = "\033[33m"
+	ansiMagenta   string = "\033[35m"
+	ansiCyan      string = "\033[36m"
+	ansiGray      string = "\033[90m"
+	ansiYanked    string = "\033[1;37;41m"
+)
+
+var terminalScopeColors = map[string]string{
+	"Security":   ansiMagenta,
+	"Changed":    ansiYellow,
+	"Added":      ansiGreen,
+	"Removed":    ansiRed,
+	"Fixed":      ansiCyan,
+	"Deprecated": ansiGray,
+}
+
+// TerminalRenderer renders a changelog as a human readable text for a terminal.
+//
+// Scopes are color-coded and yanked releases are highlighted using ANSI escape sequences.
+// Colors are disabled when NoColor is set or NO_COLOR environment variable is not empty.
+type TerminalRenderer struct {
+	// Width wraps lines at a provided amount of characters, 0 disables wrapping.
+	Width int
+	// NoColor renders a plain text, suitable for logs.
+	NoColor bool
+	// ScopeOrder defaults to DefaultScopeOrder.
+	ScopeOrder ScopeOrder
+	// PreserveScopeOrder renders scopes of parsed changes in order of their appearance in a file.
+	PreserveScopeOrder bool
+}
+
+// RenderChangelog writes a terminal formatted Changelog struct.
+func (t *TerminalRenderer) RenderChangelog(w io.Writer, c *Changelog) error {
+	var o []string
+
+	if c.Title != nil {
+		o = append(o, t.paint(*c.Title, ansiBold+ansiUnderline)+"\n")
+	}
+
+	if c.Description != nil {
+		o = append(o, strings.Join(t.wrap(*c.Description, 0, 0), "\n")+"\n")
+	}
+
+	if c.Unreleased != nil {
+		o = append(o, t.release(c.Unreleased))
+	}
+
+	for _, r := range c.Releases.descending() {
+		o = append(o, t.release(r))
+	}
+
+	_, err := io.WriteString(w, strings.Join(o, "\n"))
+	return err
+}
+
+// RenderRelease writes a terminal formatted Release struct.
+func (t *TerminalRenderer) RenderRelease(w io.Writer, r *Release) error {
+	_, err := io.WriteString(w, t.release(r))
+	return err
+}
+
+// RenderChanges writes terminal formatted Changes struct.
+func (t *TerminalRenderer) RenderChanges(w io.Writer, c *Changes) error {
+	_, err := io.WriteString(w, t.changes(c, 0))
+	return err
+}
+
+func (t *TerminalRenderer) release(r *Release) string {
+	var o []string
+
+	title := "Unreleased"
+	if r.Version != nil {
+		title = *r.Version
+		if r.Date != nil {
+			title = fmt.Sprintf("%v - %v", title, formatDate(r.Date))
+		}
+	}
+
+	title = t.paint(title, ansiBold)
+	if r.Yanked {
+		title = fmt.Sprintf("%v %v", title, t.paint("[YANKED]", ansiYanked))
+	}
+	o = append(o, title+"\n")
+
+	if r.URL != nil {
+		o = append(o, t.paint(fmt.Sprintf("  %v", *r.URL), ansiDim)+"\n")
+	}
+
+	if r.Changes != nil {
+		o = append(o, t.changes(r.Changes, 2))
+	}
+
+	return strings.Join(o, "")
+}
+
+func (t *TerminalRenderer) changes(c *Changes, indent int) string {
+	var o []string
+
+	if c.Notice != nil {
+		o = append(o, t.wrap(*c.Notice, indent, indent)...)
+	}
+
+	for _, s := range c.orderedScopes(t.ScopeOrder, t.PreserveScopeOrder) {
+		if s.entries != nil {
+			o = append(o, t.paint(fmt.Sprintf("%v%v", strings.Repeat(" ", indent), s.name), ansiBold+terminalScopeColors[s.name]))
+
+			for _, e := range *s.entries {
+				lines := t.wrap(e, indent+4, indent+4)
+				lines[0] = fmt.Sprintf("%v%v %v", strings.Repeat(" ", indent+2), t.paint("•", terminalScopeColors[s.name]), strings.TrimLeft(lines[0], " "))
+				o = append(o, lines...)
+			}
+		}
+	}
+
+	if len(o) == 0 {
+		return ""
+	}
+
+	return strings.Join(o, "\n") + "\n"
+}
+
+// wrap splits a text into lines not exceeding renderer width.
+// The first line is indented by a first indentation, the rest by a hanging indentation.
+func (t *TerminalRenderer) wrap(text string, first, hanging int) []string {
+	var o []string
+
+	indent := first
+	for _, paragraph := range strings.Split(text, "\n") {
+		o = append(o, wrapLine(paragraph, t.Width, indent, hanging, nil)...)
+		indent = hanging
+	}
+
+	return o
+}
+
+// wrapLine splits a single line by words so every line fits into a width.
+// Words longer than the width are not split, neither are lines before words rejected by a breakable function.
+func wrapLine(line string, width, first, hanging int, breakable func(word string) bool) []string {
+	if width <= 0 {
+		return []string{strings.TrimRight(strings.Repeat(" ", first)+line, " ")}
+	}
+
+	var o []string
+
+	current := strings.Repeat(" ", first)
+	words := strings.Fields(line)
+	empty := true
+
+	for _, word := range words {
+		if !empty && utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) > width && (breakable == nil || breakable(word)) {
+			o = append(o, current)
+			current = strings.Repeat(" ", hanging)
+			empty = true
+		}
+
+		if !empty {
+			current += " "
+		}
+		current += word
+		empty = false
+	}
+
+	return append(o, strings.TrimRight(current, " "))
+}
+
+func (t *TerminalRenderer) paint(text, color string) string {
+	if t.NoColor || os.Getenv("NO_COLOR") != "" {
+		return text
+	}
+
+	return fmt.Sprintf("%v%v%v", color, text, ansiReset)
+}
@@ -0,0 +1,135 @@
+package changelog_test
+
+import (
+	"bytes"
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerminalRenderer(t *testing.T) {
+	a := assert.New(t)
+	t.Setenv("NO_COLOR", "")
+
+	type test struct {
+		Renderer *changelog.TerminalRenderer
+		Expected string
+	}
+
+	c := &changelog.Changelog{
+		Title:       stringP("Changelog"),
+		Description: stringP("All notable changes of this project"),
+		Unreleased: &changelog.Release{
+			Changes: &changelog.Changes{
+				Added: sliceOfStringsP([]string{"A"}),
+			},
+		},
+		Releases: changelog.Releases{
+			{
+				Version: stringP("1.0.0"),
+				Date:    dateP("2021-05-19"),
+				Yanked:  true,
+				URL:     stringP("https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0"),
+				Changes: &changelog.Changes{
+					Notice: stringP("notice"),
+					Fixed:  sliceOfStringsP([]string{"a very long entry that has to be wrapped"}),
+				},
+			},
+		},
+	}
+
+	suite := map[string]test{
+		"Plain": {
+			Renderer: &changelog.TerminalRenderer{
+				NoColor: true,
+			},
+			Expected: `Changelog
+
+All notable changes of this project
+
+Unreleased
+  Added
+    • A
+
+1.0.0 - 2021-05-19 [YANKED]
+  https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0
+  notice
+  Fixed
+    • a very long entry that has to be wrapped
+`,
+		},
+		"Plain Wrapped": {
+			Renderer: &changelog.TerminalRenderer{
+				Width:   20,
+				NoColor: true,
+			},
+			Expected: `Changelog
+
+All notable changes
+of this project
+
+Unreleased
+  Added
+    • A
+
+1.0.0 - 2021-05-19 [YANKED]
+  https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0
+  notice
+  Fixed
+    • a very long
+      entry that has
+      to be wrapped
+`,
+		},
+		"Colors": {
+			Renderer: new(changelog.TerminalRenderer),
+			Expected: "\033[1m\033[4mChangelog\033[0m\n" +
+				"\n" +
+				"All notable changes of this project\n" +
+				"\n" +
+				"\033[1mUnreleased\033[0m\n" +
+				"\033[1m\033[32m  Added\033[0m\n" +
+				"    \033[32m•\033[0m A\n" +
+				"\n" +
+				"\033[1m1.0.0 - 2021-05-19\033[0m \033[1;37;41m[YANKED]\033[0m\n" +
+				"\033[2m  https://github.com/anton-yurchenko/go-changelog/releases/tag/v1.0.0\033[0m\n" +
+				"  notice\n" +
+				"\033[1m\033[36m  Fixed\033[0m\n" +
+				"    \033[36m•\033[0m a very long entry that has to be wrapped\n",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		b := new(bytes.Buffer)
+		a.Equal(nil, test.Renderer.RenderChangelog(b, c))
+		a.Equal(test.Expected, b.String())
+	}
+}
+
+func TestTerminalRendererNoColorEnvironment(t *testing.T) {
+	a := assert.New(t)
+	t.Setenv("NO_COLOR", "1")
+
+	t.Log("Test Case 1/2 - Release")
+	b := new(bytes.Buffer)
+	a.Equal(nil, new(changelog.TerminalRenderer).RenderRelease(b, &changelog.Release{
+		Version: stringP("1.0.0"),
+		Changes: &changelog.Changes{
+			Security: sliceOfStringsP([]string{"A\n  indented"}),
+		},
+	}))
+	a.Equal("1.0.0\n  Security\n    • A\n        indented\n", b.String())
+
+	t.Log("Test Case 2/2 - Changes")
+	b.Reset()
+	a.Equal(nil, new(changelog.TerminalRenderer).RenderChanges(b, &changelog.Changes{
+		Deprecated: sliceOfStringsP([]string{"A"}),
+	}))
+	a.Equal("Deprecated\n  • A\n", b.String())
+}
@@ -0,0 +1,225 @@
+package changelog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UnifiedDiffContext is an amount of unchanged lines surrounding changes in a unified diff.
+const UnifiedDiffContext int = 3
+
+type diffOperation struct {
+	kind byte
+	line string
+}
+
+// UnifiedDiff returns a unified diff between two texts, or an empty string when they are identical.
+func UnifiedDiff(fromName, toName, from, to string) string {
+	if from == to {
+		return ""
+	}
+
+	ops := diffLines(splitLines(from), splitLines(to))
+
+	var changes []int
+	for i, op := range ops {
+		if op.kind != ' ' {
+			changes = append(changes, i)
+		}
+	}
+
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "--- %v\n+++ %v\n", fromName, toName)
+
+	for i := 0; i < len(changes); {
+		start := max(changes[i]-UnifiedDiffContext, 0)
+
+		// NOTE: merge changes separated by up to two contexts into a single hunk
+		j := i
+		for j+1 < len(changes) && changes[j+1]-changes[j]-1 <= 2*UnifiedDiffContext {
+			j++
+		}
+		end := min(changes[j]+UnifiedDiffContext+1, len(ops))
+
+		writeHunk(b, ops, start, end)
+		i = j + 1
+	}
+
+	return b.String()
+}
+
+func writeHunk(b *strings.Builder, ops []diffOperation, start, end int) {
+	var fromLine, toLine int
+	for _, op := range ops[:start] {
+		if op.kind != '+' {
+			fromLine++
+		}
+		if op.kind != '-' {
+			toLine++
+		}
+	}
+
+	var fromCount, toCount int
+	for _, op := range ops[start:end] {
+		if op.kind != '+' {
+			fromCount++
+		}
+		if op.kind != '-' {
+			toCount++
+		}
+	}
+
+	fmt.Fprintf(b, "@@ -%v +%v @@\n", hunkRange(fromLine, fromCount), hunkRange(toLine, toCount))
+
+	for _, op := range ops[start:end] {
+		b.WriteByte(op.kind)
+		b.WriteString(op.line)
+
+		if !strings.HasSuffix(op.line, "\n") {
+			b.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange formats a range of a hunk header, a start line is 1-based unless the range is empty.
+func hunkRange(line, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%v,0", line)
+	case 1:
+		return fmt.Sprintf("%v", line+1)
+	default:
+		return fmt.Sprintf("%v,%v", line+1, count)
+	}
+}
+
+// splitLines splits a text into lines keeping their line breaks.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// diffLines returns the shortest edit script between two sets of lines using
+// the linear space variation of Myers' algorithm.
+// Deletions precede insertions within every run of changes.
+func diffLines(a, b []string) []diffOperation {
+	o := compareLines(nil, a, b)
+
+	for i := 0; i < len(o); {
+		j := i
+		for j < len(o) && o[j].kind != ' ' {
+			j++
+		}
+
+		changes := o[i:j]
+		sort.SliceStable(changes, func(x, y int) bool {
+			return changes[x].kind == '-' && changes[y].kind == '+'
+		})
+
+		i = j + 1
+	}
+
+	return o
+}
+
+// compareLines appends an edit script between two sets of lines to a list of operations,
+// it strips a common prefix and suffix and bisects the rest on a middle snake.
+func compareLines(o []diffOperation, a, b []string) []diffOperation {
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		o = append(o, diffOperation{kind: ' ', line: a[0]})
+		a, b = a[1:], b[1:]
+	}
+
+	var suffix int
+	for suffix < len(a) && suffix < len(b) && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	common := a[len(a)-suffix:]
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	switch {
+	case len(a) == 0:
+		for _, line := range b {
+			o = append(o, diffOperation{kind: '+', line: line})
+		}
+	case len(b) == 0:
+		for _, line := range a {
+			o = append(o, diffOperation{kind: '-', line: line})
+		}
+	default:
+		x, y := middleSnake(a, b)
+		o = compareLines(o, a[:x], b[:y])
+		o = compareLines(o, a[x:], b[y:])
+	}
+
+	for _, line := range common {
+		o = append(o, diffOperation{kind: ' ', line: line})
+	}
+
+	return o
+}
+
+// middleSnake returns a point of the shortest edit script between two sets of lines
+// where forward and backward searches overlap.
+// The sets must be non-empty and differ in their first and last lines,
+// so the point always splits the script into two shorter ones.
+func middleSnake(a, b []string) (int, int) {
+	n, m := len(a), len(b)
+	delta := n - m
+	odd := delta%2 != 0
+
+	limit := (n + m + 1) / 2
+	offset := limit + 1
+	forward := make([]int, 2*offset+1)
+	backward := make([]int, 2*offset+1)
+
+	for d := 0; d <= limit; d++ {
+		for k := -d; k <= d; k += 2 {
+			x := furthestPoint(forward, offset, k, d)
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			forward[offset+k] = x
+
+			if odd && delta-k >= -(d-1) && delta-k <= d-1 && x+backward[offset+delta-k] >= n {
+				return x, y
+			}
+		}
+
+		for k := -d; k <= d; k += 2 {
+			x := furthestPoint(backward, offset, k, d)
+			y := x - k
+			for x < n && y < m && a[n-1-x] == b[m-1-y] {
+				x++
+				y++
+			}
+			backward[offset+k] = x
+
+			if !odd && delta-k >= -d && delta-k <= d && x+forward[offset+delta-k] >= n {
+				return n - x, m - y
+			}
+		}
+	}
+
+	return n, m
+}
+
+// furthestPoint returns a starting x coordinate of a diagonal k on a step d of a search.
+func furthestPoint(v []int, offset, k, d int) int {
+	if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+		return v[offset+k+1]
+	}
+
+	return v[offset+k-1] + 1
+}
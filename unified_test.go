@@ -0,0 +1,85 @@
+package changelog_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	a := assert.New(t)
+
+	type test struct {
+		From     string
+		To       string
+		Expected string
+	}
+
+	suite := map[string]test{
+		"Identical": {
+			From:     "a\nb\n",
+			To:       "a\nb\n",
+			Expected: "",
+		},
+		"Single Line": {
+			From:     "a\nb\nc\n",
+			To:       "a\nx\nc\n",
+			Expected: "--- a\n+++ b\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n",
+		},
+		"From Empty": {
+			From:     "",
+			To:       "a\n",
+			Expected: "--- a\n+++ b\n@@ -0,0 +1 @@\n+a\n",
+		},
+		"No Newline": {
+			From:     "a\nb",
+			To:       "a\nb\n",
+			Expected: "--- a\n+++ b\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n",
+		},
+		"Separate Hunks": {
+			From:     "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			To:       "0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n",
+			Expected: "--- a\n+++ b\n@@ -1,3 +1,4 @@\n+0\n 1\n 2\n 3\n@@ -9,4 +10,3 @@\n 9\n 10\n 11\n-12\n",
+		},
+		"Replaced Lines": {
+			From:     "a\nb\nc\nd\n",
+			To:       "a\nx\nc\ny\nz\n",
+			Expected: "--- a\n+++ b\n@@ -1,4 +1,5 @@\n a\n-b\n+x\n c\n-d\n+y\n+z\n",
+		},
+		"Merged Hunks": {
+			From:     "1\n2\n3\n4\n5\n6\n7\n",
+			To:       "0\n1\n2\n3\n4\n5\n6\n",
+			Expected: "--- a\n+++ b\n@@ -1,7 +1,7 @@\n+0\n 1\n 2\n 3\n 4\n 5\n 6\n-7\n",
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		a.Equal(test.Expected, changelog.UnifiedDiff("a", "b", test.From, test.To))
+	}
+}
+
+func TestUnifiedDiffLargeInput(t *testing.T) {
+	a := assert.New(t)
+
+	var from, to, expected strings.Builder
+	for i := 0; i < 5000; i++ {
+		fmt.Fprintf(&from, "a%v\n", i)
+		fmt.Fprintf(&to, "b%v\n", i)
+	}
+	expected.WriteString("--- a\n+++ b\n@@ -1,5000 +1,5000 @@\n")
+	for i := 0; i < 5000; i++ {
+		fmt.Fprintf(&expected, "-a%v\n", i)
+	}
+	for i := 0; i < 5000; i++ {
+		fmt.Fprintf(&expected, "+b%v\n", i)
+	}
+
+	a.Equal(expected.String(), changelog.UnifiedDiff("a", "b", from.String(), to.String()))
+}
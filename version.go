@@ -0,0 +1,201 @@
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/mod/semver"
+)
+
+// versionBump is a level of a Semantic Version increment.
+type versionBump int
+
+const (
+	patchBump versionBump = iota
+	minorBump
+	majorBump
+)
+
+// semVer is a parsed Semantic Version, build metadata is omitted.
+type semVer struct {
+	major, minor, patch int
+	prerelease          string
+}
+
+func parseSemVer(version string) (*semVer, error) {
+	m := regexp.MustCompile(`^` + SemVerRegex + `$`).FindStringSubmatch(version)
+	if m == nil {
+		return nil, errors.New(fmt.Sprintf("invalid semantic version %v, expected to match regex %v", version, SemVerRegex))
+	}
+
+	o := &semVer{prerelease: m[4]}
+	for i, p := range []*int{&o.major, &o.minor, &o.patch} {
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid semantic version %v", version)
+		}
+		*p = n
+	}
+
+	return o, nil
+}
+
+func (v semVer) String() string {
+	if v.prerelease != "" {
+		return fmt.Sprintf("%v.%v.%v-%v", v.major, v.minor, v.patch, v.prerelease)
+	}
+
+	return fmt.Sprintf("%v.%v.%v", v.major, v.minor, v.patch)
+}
+
+// bump increments a stable version, breaking changes of 0.x versions increment a minor version.
+func (v semVer) bump(b versionBump) semVer {
+	if b == majorBump && v.major == 0 {
+		b = minorBump
+	}
+
+	switch b {
+	case majorBump:
+		return semVer{major: v.major + 1}
+	case minorBump:
+		return semVer{major: v.major, minor: v.minor + 1}
+	default:
+		return semVer{major: v.major, minor: v.minor, patch: v.patch + 1}
+	}
+}
+
+// core returns a version without a prerelease.
+func (v semVer) core() string {
+	return semVer{major: v.major, minor: v.minor, patch: v.patch}.String()
+}
+
+// nextPrerelease increments the last numeric identifier of a prerelease (rc.1 -> rc.2),
+// a numeric identifier is appended when missing (beta -> beta.1).
+func nextPrerelease(prerelease string) string {
+	identifiers := strings.Split(prerelease, ".")
+
+	last := identifiers[len(identifiers)-1]
+	if n, err := strconv.Atoi(last); err == nil {
+		identifiers[len(identifiers)-1] = strconv.Itoa(n + 1)
+		return strings.Join(identifiers, ".")
+	}
+
+	return prerelease + ".1"
+}
+
+// firstPrerelease returns the first prerelease of the same name (rc.2 -> rc.1, beta -> beta.1, 2 -> 1).
+func firstPrerelease(prerelease string) string {
+	identifiers := strings.Split(prerelease, ".")
+	if _, err := strconv.Atoi(identifiers[len(identifiers)-1]); err == nil {
+		identifiers = identifiers[:len(identifiers)-1]
+	}
+
+	return strings.Join(append(identifiers, "1"), ".")
+}
+
+// bump returns a required version increment of changes.
+//
+// Removed scope or entries prefixed with **Breaking** require a major increment,
+// Added, Changed and Deprecated scopes require a minor increment, Fixed and Security scopes require a patch increment.
+func (c *Changes) bump() (versionBump, error) {
+	if len(c.nonEmptyScopes()) == 0 {
+		return patchBump, errors.New("missing unreleased changes")
+	}
+
+	m := regexp.MustCompile(BreakingChangeRegex)
+	o := patchBump
+
+	for _, s := range c.nonEmptyScopes() {
+		for _, e := range *s.entries {
+			if m.MatchString(e) {
+				return majorBump, nil
+			}
+		}
+
+		switch s.name {
+		case "Removed":
+			return majorBump, nil
+		case "Added", "Changed", "Deprecated":
+			o = minorBump
+		}
+	}
+
+	return o, nil
+}
+
+// nonEmptyScopes returns scopes containing entries.
+func (c *Changes) nonEmptyScopes() []scope {
+	var o []scope
+	for _, s := range c.scopes() {
+		if s.entries != nil && len(*s.entries) > 0 {
+			o = append(o, s)
+		}
+	}
+
+	return o
+}
+
+// NextVersion infers a version of the next release from Unreleased changes and the latest release.
+//
+// A major version is incremented for Removed changes or entries prefixed with **Breaking**,
+// a minor version for Added, Changed or Deprecated changes, and a patch version for Fixed or Security changes only.
+// Breaking changes of 0.x versions increment a minor version.
+//
+// When the latest release is a prerelease of the inferred version or a higher one, its prerelease is incremented
+// (1.0.0-rc.1 -> 1.0.0-rc.2), otherwise a new prerelease of the same name is started (1.0.0-rc.2 -> 2.0.0-rc.1).
+func (c *Changelog) NextVersion() (string, error) {
+	if c.Unreleased == nil || c.Unreleased.Changes == nil {
+		return "", errors.New("missing 'Unreleased' section")
+	}
+
+	b, err := c.Unreleased.Changes.bump()
+	if err != nil {
+		return "", err
+	}
+
+	stable := new(semVer)
+	var latest *semVer
+
+	for _, r := range c.Releases.descending() {
+		v, err := parseSemVer(*r.Version)
+		if err != nil {
+			return "", err
+		}
+
+		if latest == nil {
+			latest = v
+		}
+
+		if v.prerelease == "" {
+			stable = v
+			break
+		}
+	}
+
+	next := stable.bump(b)
+	if latest == nil || latest.prerelease == "" {
+		return next.String(), nil
+	}
+
+	if semver.Compare("v"+latest.core(), "v"+next.String()) >= 0 {
+		latest.prerelease = nextPrerelease(latest.prerelease)
+		return latest.String(), nil
+	}
+
+	next.prerelease = firstPrerelease(latest.prerelease)
+	return next.String(), nil
+}
+
+// ReleaseNext creates a new release with all the changes from Unreleased section using a version inferred by NextVersion.
+// This will also cleanup the Unreleased section.
+func (c *Changelog) ReleaseNext(date string) (*Release, error) {
+	v, err := c.NextVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateReleaseFromUnreleased(v, date)
+}
@@ -0,0 +1,154 @@
+package changelog_test
+
+import (
+	"testing"
+
+	changelog "github.com/anton-yurchenko/go-changelog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextVersion(t *testing.T) {
+	a := assert.New(t)
+
+	type expected struct {
+		Version string
+		Error   string
+	}
+
+	type test struct {
+		Unreleased *changelog.Changes
+		Versions   []string
+		Expected   expected
+	}
+
+	suite := map[string]test{
+		"Patch": {
+			Unreleased: &changelog.Changes{Fixed: sliceOfStringsP([]string{"A"}), Security: sliceOfStringsP([]string{"B"})},
+			Versions:   []string{"1.1.0", "1.2.3", "0.9.0"},
+			Expected:   expected{Version: "1.2.4"},
+		},
+		"Minor Added": {
+			Unreleased: &changelog.Changes{Added: sliceOfStringsP([]string{"A"}), Fixed: sliceOfStringsP([]string{"B"})},
+			Versions:   []string{"1.2.3"},
+			Expected:   expected{Version: "1.3.0"},
+		},
+		"Minor Deprecated": {
+			Unreleased: &changelog.Changes{Deprecated: sliceOfStringsP([]string{"A"})},
+			Versions:   []string{"1.2.3"},
+			Expected:   expected{Version: "1.3.0"},
+		},
+		"Minor Changed": {
+			Unreleased: &changelog.Changes{Changed: sliceOfStringsP([]string{"A"})},
+			Versions:   []string{"1.2.3"},
+			Expected:   expected{Version: "1.3.0"},
+		},
+		"Major Removed": {
+			Unreleased: &changelog.Changes{Removed: sliceOfStringsP([]string{"A"}), Added: sliceOfStringsP([]string{"B"})},
+			Versions:   []string{"1.2.3"},
+			Expected:   expected{Version: "2.0.0"},
+		},
+		"Major Breaking": {
+			Unreleased: &changelog.Changes{Changed: sliceOfStringsP([]string{"A", "**Breaking:** B"})},
+			Versions:   []string{"1.2.3"},
+			Expected:   expected{Version: "2.0.0"},
+		},
+		"Major Breaking Change": {
+			Unreleased: &changelog.Changes{Fixed: sliceOfStringsP([]string{"**BREAKING CHANGE** A"})},
+			Versions:   []string{"1.2.3"},
+			Expected:   expected{Version: "2.0.0"},
+		},
+		"Zero Major": {
+			Unreleased: &changelog.Changes{Removed: sliceOfStringsP([]string{"A"})},
+			Versions:   []string{"0.2.3"},
+			Expected:   expected{Version: "0.3.0"},
+		},
+		"Zero Patch": {
+			Unreleased: &changelog.Changes{Fixed: sliceOfStringsP([]string{"A"})},
+			Versions:   []string{"0.2.3"},
+			Expected:   expected{Version: "0.2.4"},
+		},
+		"Initial": {
+			Unreleased: &changelog.Changes{Added: sliceOfStringsP([]string{"A"})},
+			Expected:   expected{Version: "0.1.0"},
+		},
+		"Prerelease": {
+			Unreleased: &changelog.Changes{Fixed: sliceOfStringsP([]string{"A"})},
+			Versions:   []string{"1.2.3", "2.0.0-rc.1"},
+			Expected:   expected{Version: "2.0.0-rc.2"},
+		},
+		"Prerelease Without Number": {
+			Unreleased: &changelog.Changes{Added: sliceOfStringsP([]string{"A"})},
+			Versions:   []string{"1.2.3", "1.3.0-beta"},
+			Expected:   expected{Version: "1.3.0-beta.1"},
+		},
+		"Prerelease Of Higher Version": {
+			Unreleased: &changelog.Changes{Removed: sliceOfStringsP([]string{"A"})},
+			Versions:   []string{"1.2.3", "1.3.0-rc.2"},
+			Expected:   expected{Version: "2.0.0-rc.1"},
+		},
+		"Stable After Prerelease": {
+			Unreleased: &changelog.Changes{Fixed: sliceOfStringsP([]string{"A"})},
+			Versions:   []string{"2.0.0-rc.1", "2.0.0"},
+			Expected:   expected{Version: "2.0.1"},
+		},
+		"Missing Unreleased": {
+			Versions: []string{"1.2.3"},
+			Expected: expected{Error: "missing 'Unreleased' section"},
+		},
+		"Notice Only": {
+			Unreleased: &changelog.Changes{Notice: stringP("notice"), Added: &[]string{}},
+			Versions:   []string{"1.2.3"},
+			Expected:   expected{Error: "missing unreleased changes"},
+		},
+	}
+
+	var counter int
+	for name, test := range suite {
+		counter++
+		t.Logf("Test Case %v/%v - %s", counter, len(suite), name)
+
+		c := changelog.NewChangelog()
+		if test.Unreleased != nil {
+			c.Unreleased = &changelog.Release{Changes: test.Unreleased}
+		}
+
+		for _, v := range test.Versions {
+			if _, err := c.CreateRelease(v, "2021-05-19"); err != nil {
+				t.Fatalf("error preparing test case: %v", err)
+			}
+		}
+
+		v, err := c.NextVersion()
+		if test.Expected.Error != "" {
+			a.EqualError(err, test.Expected.Error)
+		} else {
+			a.Equal(nil, err)
+			a.Equal(test.Expected.Version, v)
+		}
+	}
+}
+
+func TestReleaseNext(t *testing.T) {
+	a := assert.New(t)
+
+	t.Log("Test Case 1/2 - Release")
+	c := changelog.NewChangelog()
+	if _, err := c.CreateRelease("1.2.3", "2021-05-19"); err != nil {
+		t.Fatalf("error preparing test case: %v", err)
+	}
+	if err := c.AddUnreleasedChange("added", "A"); err != nil {
+		t.Fatalf("error preparing test case: %v", err)
+	}
+
+	r, err := c.ReleaseNext("2021-05-20")
+	a.Equal(nil, err)
+	a.Equal(stringP("1.3.0"), r.Version)
+	a.Equal(dateP("2021-05-20"), r.Date)
+	a.Equal(&changelog.Changes{Added: sliceOfStringsP([]string{"A"})}, r.Changes)
+	a.Nil(c.Unreleased.Changes)
+
+	t.Log("Test Case 2/2 - Nothing To Release")
+	r, err = c.ReleaseNext("2021-05-21")
+	a.EqualError(err, "missing 'Unreleased' section")
+	a.Nil(r)
+}